@@ -1,20 +1,60 @@
 package dependency
 
 import (
+	"database/sql"
+	"log/slog"
+
 	"github.com/EstebanGitPro/motogo-backend/config"
 	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/oidc"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/sms"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/storage"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
 	"github.com/EstebanGitPro/motogo-backend/core/services"
 
-	mysql "github.com/EstebanGitPro/motogo-backend/platform/mysql"	
-	
+	"github.com/EstebanGitPro/motogo-backend/core/ports/mailer"
+	"github.com/EstebanGitPro/motogo-backend/platform/hasher"
+	"github.com/EstebanGitPro/motogo-backend/platform/imaging"
+	"github.com/EstebanGitPro/motogo-backend/platform/jwt"
+	"github.com/EstebanGitPro/motogo-backend/platform/loginattempt"
+	mailerAdapter "github.com/EstebanGitPro/motogo-backend/platform/mailer"
+	mysql "github.com/EstebanGitPro/motogo-backend/platform/mysql"
+	"github.com/EstebanGitPro/motogo-backend/platform/mysql/migrations"
+	oidcAdapter "github.com/EstebanGitPro/motogo-backend/platform/oidc"
+	"github.com/EstebanGitPro/motogo-backend/platform/secretbox"
+	smsAdapter "github.com/EstebanGitPro/motogo-backend/platform/sms"
+	storageAdapter "github.com/EstebanGitPro/motogo-backend/platform/storage"
+	"github.com/EstebanGitPro/motogo-backend/platform/totp"
+
+	"github.com/EstebanGitPro/motogo-backend/repositories/accountdeletion"
+	"github.com/EstebanGitPro/motogo-backend/repositories/apikey"
+	"github.com/EstebanGitPro/motogo-backend/repositories/externalidentity"
+	loginAttemptRepo "github.com/EstebanGitPro/motogo-backend/repositories/loginattempt"
+	"github.com/EstebanGitPro/motogo-backend/repositories/mfa"
 	repo "github.com/EstebanGitPro/motogo-backend/repositories/person"
+	"github.com/EstebanGitPro/motogo-backend/repositories/phoneotp"
+	"github.com/EstebanGitPro/motogo-backend/repositories/refreshtoken"
+	"github.com/EstebanGitPro/motogo-backend/repositories/session"
+	"github.com/EstebanGitPro/motogo-backend/repositories/signingkey"
+	"github.com/EstebanGitPro/motogo-backend/repositories/verification"
 )
 
 type Dependencies struct {
-	PersonService ports.Service
-	PersonRepo    ports.Repository
-	Config        *config.Config
-	
+	PersonService       ports.Service
+	PersonRepo          ports.Repository
+	AuthService         ports.AuthService
+	VerificationService ports.VerificationService
+	PhoneService        ports.PhoneVerificationService
+	PasswordService     ports.PasswordService
+	MFAService          ports.MFAService
+	SessionService      ports.SessionService
+	SigningKeyService   ports.SigningKeyService
+	APIKeyService       ports.APIKeyService
+	OIDCService         ports.OIDCService
+	PrivacyService      ports.PrivacyService
+	PhotoService        ports.PhotoService
+	TokenGenerator      token.Generator
+	Config              *config.Config
 }
 
 func Init() (*Dependencies, error) {
@@ -25,14 +65,176 @@ func Init() (*Dependencies, error) {
 		return nil, err
 	}
 
+	if err := ensureSchemaCurrent(db); err != nil {
+		return nil, err
+	}
 
+	argon2idConfig := cfg.Password.Argon2id.WithDefaults()
+	passwordHasher := hasher.NewHasher(hasher.Argon2idParams{
+		Memory:      argon2idConfig.MemoryKiB,
+		Iterations:  argon2idConfig.Iterations,
+		Parallelism: argon2idConfig.Parallelism,
+		SaltLength:  argon2idConfig.SaltLength,
+		KeyLength:   argon2idConfig.KeyLength,
+	})
 
 	personRepo := repo.NewRepository(db)
-	personService := services.NewService(personRepo, cfg)
+	verificationTokenRepo := verification.NewRepository(db)
+	emailSender := newMailer(cfg)
+	loginAttempts := newLoginAttemptStore(db, cfg)
+	verificationService := services.NewVerificationService(personRepo, verificationTokenRepo, emailSender, loginAttempts, cfg)
+	phoneOTPRepo := phoneotp.NewRepository(db)
+	phoneService := services.NewPhoneVerificationService(personRepo, phoneOTPRepo, newSMSSender(cfg), cfg)
+	personService := services.NewService(personRepo, verificationService, phoneService, passwordHasher, cfg)
+
+	tokenGenerator, signingKeyService, err := newTokenSigning(db, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaSecrets, err := secretbox.New(cfg.MFA.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	mfaRepo := mfa.NewRepository(db, mfaSecrets)
+	mfaService := services.NewMFAService(personRepo, mfaRepo, totp.NewTOTP(cfg.MFA.IssuerName()), cfg)
+
+	refreshTokenRepo := refreshtoken.NewRepository(db)
+	sessionRepo := session.NewRepository(db)
+	sessionService := services.NewSessionService(sessionRepo, refreshTokenRepo)
+	apiKeyService := services.NewAPIKeyService(apikey.NewRepository(db))
+	passwordService := services.NewPasswordService(personRepo, verificationTokenRepo, refreshTokenRepo, sessionRepo, emailSender, passwordHasher, loginAttempts, cfg)
+	authService := services.NewAuthService(personRepo, refreshTokenRepo, sessionRepo, tokenGenerator, passwordHasher, mfaService, loginAttempts, cfg)
+
+	externalIdentityRepo := externalidentity.NewRepository(db)
+	oidcService := services.NewOIDCService(personRepo, externalIdentityRepo, newOIDCProviders(cfg), authService, passwordHasher, cfg)
+
+	objectStorage, err := NewObjectStorage(cfg)
+	if err != nil {
+		return nil, err
+	}
+	photoService := services.NewPhotoService(personRepo, imaging.NewProcessor(), objectStorage, cfg)
+	privacyService := services.NewPrivacyService(personRepo, accountdeletion.NewRepository(db), sessionRepo, externalIdentityRepo, mfaRepo, objectStorage, cfg)
 
 	return &Dependencies{
-		PersonService: personService,
-		PersonRepo:    personRepo,
-		Config:        cfg,
+		PersonService:       personService,
+		PersonRepo:          personRepo,
+		AuthService:         authService,
+		VerificationService: verificationService,
+		PhoneService:        phoneService,
+		PasswordService:     passwordService,
+		MFAService:          mfaService,
+		SessionService:      sessionService,
+		SigningKeyService:   signingKeyService,
+		APIKeyService:       apiKeyService,
+		OIDCService:         oidcService,
+		PrivacyService:      privacyService,
+		PhotoService:        photoService,
+		TokenGenerator:      tokenGenerator,
+		Config:              cfg,
 	}, nil
-}
\ No newline at end of file
+}
+
+// ensureSchemaCurrent refuses to start against a database that is missing
+// migrations this build relies on.
+func ensureSchemaCurrent(db *sql.DB) error {
+	migrator, err := migrations.New(db)
+	if err != nil {
+		return err
+	}
+	return migrator.EnsureCurrent()
+}
+
+// newTokenSigning builds the access token generator. With an asymmetric
+// algorithm it is a keyring backed by the rotated keys in MySQL, and a first
+// key is created when none exists yet.
+func newTokenSigning(db *sql.DB, cfg *config.Config) (token.Generator, ports.SigningKeyService, error) {
+	if !cfg.JWT.UsesAsymmetricKeys() {
+		generator, err := jwt.NewGenerator(cfg.JWT.SecretKey, cfg.JWT.Issuer)
+		if err != nil {
+			return nil, nil, err
+		}
+		return generator, services.NewSigningKeyService(nil, nil, nil, cfg), nil
+	}
+
+	keyEncryption, err := secretbox.New(cfg.JWT.KeyEncryptionKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	staticKeys := make([]jwt.StaticKey, 0, len(cfg.JWT.Keys))
+	for _, key := range cfg.JWT.Keys {
+		privateKey, publicKey, err := key.Load()
+		if err != nil {
+			return nil, nil, err
+		}
+		staticKeys = append(staticKeys, jwt.StaticKey{ID: key.ID, PrivateKey: privateKey, PublicKey: publicKey})
+	}
+
+	signingKeyRepo := signingkey.NewRepository(db, keyEncryption)
+	keyring, err := jwt.NewKeyring(cfg.JWT.Issuer, staticKeys, signingKeyRepo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signingKeyService := services.NewSigningKeyService(signingKeyRepo, jwt.NewKeyFactory(), keyring, cfg)
+	if err := signingKeyService.EnsureSigningKey(); err != nil {
+		return nil, nil, err
+	}
+
+	return keyring, signingKeyService, nil
+}
+
+// newOIDCProviders builds the configured social login providers.
+func newOIDCProviders(cfg *config.Config) []oidc.Provider {
+	providers := make([]oidc.Provider, 0, len(cfg.OIDC.Providers))
+	for _, provider := range cfg.OIDC.Providers {
+		providers = append(providers, oidcAdapter.NewProvider(oidcAdapter.ProviderConfig{
+			Name:         provider.Name,
+			Issuer:       provider.Issuer,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+			Scopes:       provider.Scopes,
+		}, nil))
+	}
+	return providers
+}
+
+// NewObjectStorage builds the configured storage for uploaded files. It is
+// exported for the commands that clean up stored files.
+func NewObjectStorage(cfg *config.Config) (storage.ObjectStorage, error) {
+	if cfg.Storage.UsesS3() {
+		return storageAdapter.NewS3Storage(cfg.Storage.S3, cfg.Storage.PublicBaseURL)
+	}
+	return storageAdapter.NewLocalStorage(cfg.Storage.Directory(), cfg.Storage.LocalBaseURL()), nil
+}
+
+func newMailer(cfg *config.Config) mailer.Mailer {
+	if cfg.Mailer.UsesResend() {
+		return mailerAdapter.NewResendMailer(cfg.Resend.APIKey, cfg.Resend.FromEmail)
+	}
+	return mailerAdapter.NewLocalMailer(cfg.Mailer.OutboxDir)
+}
+
+// newSMSSender builds the SMS adapter. The in-memory sender only logs the
+// message bodies, which carry one-time codes, in the local environment.
+func newSMSSender(cfg *config.Config) sms.Sender {
+	if cfg.SMS.UsesTwilio() {
+		return smsAdapter.NewTwilioSender(cfg.SMS.Twilio.AccountSID, cfg.SMS.Twilio.AuthToken, cfg.SMS.Twilio.FromNumber)
+	}
+	if !cfg.IsLocal() {
+		slog.Warn("SMS are kept in memory and not delivered", slog.String("environment", cfg.Environment))
+	}
+	return smsAdapter.NewMemorySender(cfg.IsLocal())
+}
+
+// newLoginAttemptStore builds the store shared by the login guard and the
+// email throttles, keeping entries for the longest of their windows.
+func newLoginAttemptStore(db *sql.DB, cfg *config.Config) ports.LoginAttemptStore {
+	retention := max(cfg.LoginProtection.WithDefaults().Window(), cfg.PasswordReset.RequestWindow(), cfg.Verification.ResendCooldown())
+	if cfg.LoginProtection.UsesMySQL() {
+		return loginAttemptRepo.NewRepository(db, retention)
+	}
+	return loginattempt.NewMemoryStore(retention)
+}
@@ -0,0 +1,46 @@
+// Command emailcollisions reports the persons whose emails share a canonical
+// form. Those accounts have to be merged or edited by hand before the unique
+// index on persons.email_canonical can be created. It exits with status 1
+// when collisions are found.
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	mysql "github.com/EstebanGitPro/motogo-backend/platform/mysql"
+	repo "github.com/EstebanGitPro/motogo-backend/repositories/person"
+)
+
+func main() {
+	cfg := config.MustLoadConfig()
+
+	db, err := mysql.GetDB(cfg.Database)
+	if err != nil {
+		slog.Error("Error connecting to database", slog.String("error", err.Error()))
+		os.Exit(2)
+	}
+	defer db.Close()
+
+	accounts, err := repo.NewRepository(db).ListEmailAccounts()
+	if err != nil {
+		slog.Error("Error reading emails", slog.String("error", err.Error()))
+		os.Exit(2)
+	}
+
+	collisions := domain.FindEmailCollisions(accounts)
+	for _, collision := range collisions {
+		fmt.Println(collision.CanonicalEmail)
+		for _, account := range collision.Accounts {
+			fmt.Printf("\t%s\t%s\n", account.PersonID, account.Email)
+		}
+	}
+
+	fmt.Printf("%d emails checked, %d collisions\n", len(accounts), len(collisions))
+	if len(collisions) > 0 {
+		os.Exit(1)
+	}
+}
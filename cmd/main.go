@@ -2,11 +2,20 @@ package main
 
 import (
     "log/slog"
+    "os"
+
+    "github.com/EstebanGitPro/motogo-backend/config"
+    mysql "github.com/EstebanGitPro/motogo-backend/platform/mysql"
+    "github.com/EstebanGitPro/motogo-backend/platform/mysql/migrations"
     "github.com/EstebanGitPro/motogo-backend/server"
     "github.com/gin-gonic/gin"
 )
 
 func main() {
+    if len(os.Args) > 1 && os.Args[1] == "migrate" {
+        os.Exit(migrate(os.Args[2:]))
+    }
+
     gin.SetMode(gin.ReleaseMode)
 
     app := gin.New()
@@ -22,4 +31,22 @@ func main() {
         slog.Error("Server failed to start", slog.String("error", err.Error()))
         return
     }
-}
\ No newline at end of file
+}
+
+// migrate runs the migrate subcommand, e.g. "go run ./cmd migrate up".
+func migrate(args []string) int {
+    cfg := config.MustLoadConfig()
+
+    db, err := mysql.GetDB(cfg.Database)
+    if err != nil {
+        slog.Error("Error connecting to database", slog.String("error", err.Error()))
+        return 1
+    }
+    defer db.Close()
+
+    if err := migrations.Run(db, args, os.Stdout); err != nil {
+        slog.Error("Migration failed", slog.String("error", err.Error()))
+        return 1
+    }
+    return 0
+}
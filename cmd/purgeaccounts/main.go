@@ -0,0 +1,61 @@
+// Command purgeaccounts anonymizes the accounts whose deletion grace period
+// has ended. It is meant to run on a schedule, for example once a day.
+package main
+
+import (
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/cmd/dependency"
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/services"
+	mysql "github.com/EstebanGitPro/motogo-backend/platform/mysql"
+	"github.com/EstebanGitPro/motogo-backend/platform/secretbox"
+	"github.com/EstebanGitPro/motogo-backend/repositories/accountdeletion"
+	"github.com/EstebanGitPro/motogo-backend/repositories/externalidentity"
+	"github.com/EstebanGitPro/motogo-backend/repositories/mfa"
+	repo "github.com/EstebanGitPro/motogo-backend/repositories/person"
+	"github.com/EstebanGitPro/motogo-backend/repositories/session"
+)
+
+func main() {
+	cfg := config.MustLoadConfig()
+
+	db, err := mysql.GetDB(cfg.Database)
+	if err != nil {
+		slog.Error("Error connecting to database", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	mfaSecrets, err := secretbox.New(cfg.MFA.EncryptionKey)
+	if err != nil {
+		slog.Error("Error loading mfa encryption key", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	objectStorage, err := dependency.NewObjectStorage(cfg)
+	if err != nil {
+		slog.Error("Error creating object storage", slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	privacyService := services.NewPrivacyService(
+		repo.NewRepository(db),
+		accountdeletion.NewRepository(db),
+		session.NewRepository(db),
+		externalidentity.NewRepository(db),
+		mfa.NewRepository(db, mfaSecrets),
+		objectStorage,
+		cfg,
+	)
+
+	purged, err := privacyService.PurgeDueAccounts(time.Now())
+	if err != nil {
+		slog.Error("Error purging accounts", slog.Int("purged", purged), slog.String("error", err.Error()))
+		os.Exit(1)
+	}
+
+	slog.Info("Purged deleted accounts", slog.Int("purged", purged))
+}
@@ -6,21 +6,270 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"time"
+
 	"github.com/EstebanGitPro/motogo-backend/tools/utils"
 )
 
 type Config struct {
-	Environment  string       `json:"environment"`
-	Database     Database     `json:"database"`
-	Server       Server       `json:"server"`
-	Resend       Resend       `json:"resend"`
-	JWT          JWTConfig    `json:"jwt"`
-	Verification Verification `json:"verification"`
+	Environment       string            `json:"environment"`
+	Database          Database          `json:"database"`
+	Server            Server            `json:"server"`
+	Resend            Resend            `json:"resend"`
+	Mailer            Mailer            `json:"mailer"`
+	SMS               SMS               `json:"sms"`
+	JWT               JWTConfig         `json:"jwt"`
+	Verification      Verification      `json:"verification"`
+	PhoneVerification PhoneVerification `json:"phone_verification"`
+	PasswordReset     PasswordReset     `json:"password_reset"`
+	Password          Password          `json:"password"`
+	LoginProtection   LoginProtection   `json:"login_protection"`
+	MFA               MFA               `json:"mfa"`
+	OIDC              OIDC              `json:"oidc"`
+	AccountDeletion   AccountDeletion   `json:"account_deletion"`
+	Storage           Storage           `json:"storage"`
+	ProfilePhoto      ProfilePhoto      `json:"profile_photo"`
 }
 
-
 type Verification struct {
-	BaseURL string `json:"base_url"`
+	BaseURL               string `json:"base_url"`
+	TokenTTLHours         int    `json:"token_ttl_hours,omitempty"`
+	ResendCooldownSeconds int    `json:"resend_cooldown_seconds,omitempty"`
+}
+
+func (v Verification) TokenTTL() time.Duration {
+	if v.TokenTTLHours <= 0 {
+		return 24 * time.Hour
+	}
+	return time.Duration(v.TokenTTLHours) * time.Hour
+}
+
+func (v Verification) ResendCooldown() time.Duration {
+	if v.ResendCooldownSeconds <= 0 {
+		return time.Minute
+	}
+	return time.Duration(v.ResendCooldownSeconds) * time.Second
+}
+
+// Password holds the argon2id parameters used for new password hashes.
+// Changing them makes existing hashes be upgraded on the next login.
+type Password struct {
+	Argon2id Argon2id `json:"argon2id"`
+}
+
+type Argon2id struct {
+	MemoryKiB   uint32 `json:"memory_kib,omitempty"`
+	Iterations  uint32 `json:"iterations,omitempty"`
+	Parallelism uint8  `json:"parallelism,omitempty"`
+	SaltLength  uint32 `json:"salt_length,omitempty"`
+	KeyLength   uint32 `json:"key_length,omitempty"`
+}
+
+func (a Argon2id) WithDefaults() Argon2id {
+	if a.MemoryKiB == 0 {
+		a.MemoryKiB = 19 * 1024
+	}
+	if a.Iterations == 0 {
+		a.Iterations = 2
+	}
+	if a.Parallelism == 0 {
+		a.Parallelism = 1
+	}
+	if a.SaltLength == 0 {
+		a.SaltLength = 16
+	}
+	if a.KeyLength == 0 {
+		a.KeyLength = 32
+	}
+	return a
+}
+
+// LoginProtection tunes brute-force protection on login. Store is "mysql" to
+// share counters between instances or "memory" for a single process.
+type LoginProtection struct {
+	Store               string `json:"store,omitempty"`
+	WindowMinutes       int    `json:"window_minutes,omitempty"`
+	DelayAfterFailures  int    `json:"delay_after_failures,omitempty"`
+	BaseDelaySeconds    int    `json:"base_delay_seconds,omitempty"`
+	MaxDelaySeconds     int    `json:"max_delay_seconds,omitempty"`
+	MaxFailuresPerEmail int    `json:"max_failures_per_email,omitempty"`
+	MaxFailuresPerIP    int    `json:"max_failures_per_ip,omitempty"`
+	LockoutMinutes      int    `json:"lockout_minutes,omitempty"`
+}
+
+func (l LoginProtection) UsesMySQL() bool {
+	return l.Store == "mysql"
+}
+
+func (l LoginProtection) WithDefaults() LoginProtection {
+	if l.WindowMinutes <= 0 {
+		l.WindowMinutes = 15
+	}
+	if l.DelayAfterFailures <= 0 {
+		l.DelayAfterFailures = 3
+	}
+	if l.BaseDelaySeconds <= 0 {
+		l.BaseDelaySeconds = 1
+	}
+	if l.MaxDelaySeconds <= 0 {
+		l.MaxDelaySeconds = 30
+	}
+	if l.MaxFailuresPerEmail <= 0 {
+		l.MaxFailuresPerEmail = 10
+	}
+	if l.MaxFailuresPerIP <= 0 {
+		l.MaxFailuresPerIP = 100
+	}
+	if l.LockoutMinutes <= 0 {
+		l.LockoutMinutes = 15
+	}
+	return l
+}
+
+func (l LoginProtection) Window() time.Duration {
+	return time.Duration(l.WindowMinutes) * time.Minute
+}
+
+func (l LoginProtection) BaseDelay() time.Duration {
+	return time.Duration(l.BaseDelaySeconds) * time.Second
+}
+
+func (l LoginProtection) MaxDelay() time.Duration {
+	return time.Duration(l.MaxDelaySeconds) * time.Second
+}
+
+func (l LoginProtection) Lockout() time.Duration {
+	return time.Duration(l.LockoutMinutes) * time.Minute
+}
+
+// MFA configures TOTP two-factor authentication. EncryptionKey is a base64
+// encoded 32-byte key used to encrypt the TOTP secrets at rest.
+type MFA struct {
+	Issuer                 string `json:"issuer,omitempty"`
+	EncryptionKey          string `json:"encryption_key"`
+	PendingTokenTTLMinutes int    `json:"pending_token_ttl_minutes,omitempty"`
+	RecoveryCodeCount      int    `json:"recovery_code_count,omitempty"`
+}
+
+func (m MFA) IssuerName() string {
+	if m.Issuer == "" {
+		return "MotoGo"
+	}
+	return m.Issuer
+}
+
+func (m MFA) PendingTokenTTL() time.Duration {
+	if m.PendingTokenTTLMinutes <= 0 {
+		return 5 * time.Minute
+	}
+	return time.Duration(m.PendingTokenTTLMinutes) * time.Minute
+}
+
+func (m MFA) RecoveryCodes() int {
+	if m.RecoveryCodeCount <= 0 {
+		return 10
+	}
+	return m.RecoveryCodeCount
+}
+
+// OIDC configures social login through OpenID Connect providers.
+type OIDC struct {
+	StateTTLMinutes int            `json:"state_ttl_minutes,omitempty"`
+	Providers       []OIDCProvider `json:"providers,omitempty"`
+}
+
+type OIDCProvider struct {
+	Name         string   `json:"name"`
+	Issuer       string   `json:"issuer"`
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	RedirectURL  string   `json:"redirect_url"`
+	Scopes       []string `json:"scopes,omitempty"`
+}
+
+func (o OIDC) StateTTL() time.Duration {
+	if o.StateTTLMinutes <= 0 {
+		return 10 * time.Minute
+	}
+	return time.Duration(o.StateTTLMinutes) * time.Minute
+}
+
+// AccountDeletion configures how long a deletion request can be cancelled
+// before the account is anonymized.
+type AccountDeletion struct {
+	GracePeriodDays int `json:"grace_period_days,omitempty"`
+}
+
+func (a AccountDeletion) GracePeriod() time.Duration {
+	if a.GracePeriodDays <= 0 {
+		return 30 * 24 * time.Hour
+	}
+	return time.Duration(a.GracePeriodDays) * 24 * time.Hour
+}
+
+// PasswordReset limits how many reset emails can be requested per email and
+// per client IP within the request window.
+type PasswordReset struct {
+	BaseURL              string `json:"base_url"`
+	TokenTTLMinutes      int    `json:"token_ttl_minutes,omitempty"`
+	RequestWindowMinutes int    `json:"request_window_minutes,omitempty"`
+	MaxRequestsPerEmail  int    `json:"max_requests_per_email,omitempty"`
+	MaxRequestsPerIP     int    `json:"max_requests_per_ip,omitempty"`
+}
+
+func (p PasswordReset) TokenTTL() time.Duration {
+	if p.TokenTTLMinutes <= 0 {
+		return 30 * time.Minute
+	}
+	return time.Duration(p.TokenTTLMinutes) * time.Minute
+}
+
+func (p PasswordReset) RequestWindow() time.Duration {
+	if p.RequestWindowMinutes <= 0 {
+		return time.Hour
+	}
+	return time.Duration(p.RequestWindowMinutes) * time.Minute
+}
+
+func (p PasswordReset) EmailRequestLimit() int {
+	if p.MaxRequestsPerEmail <= 0 {
+		return 3
+	}
+	return p.MaxRequestsPerEmail
+}
+
+func (p PasswordReset) IPRequestLimit() int {
+	if p.MaxRequestsPerIP <= 0 {
+		return 20
+	}
+	return p.MaxRequestsPerIP
+}
+
+type PhoneVerification struct {
+	CodeTTLMinutes        int `json:"code_ttl_minutes,omitempty"`
+	MaxAttempts           int `json:"max_attempts,omitempty"`
+	ResendCooldownSeconds int `json:"resend_cooldown_seconds,omitempty"`
+}
+
+func (p PhoneVerification) CodeTTL() time.Duration {
+	if p.CodeTTLMinutes <= 0 {
+		return 10 * time.Minute
+	}
+	return time.Duration(p.CodeTTLMinutes) * time.Minute
+}
+
+func (p PhoneVerification) MaxCodeAttempts() int {
+	if p.MaxAttempts <= 0 {
+		return 5
+	}
+	return p.MaxAttempts
+}
+
+func (p PhoneVerification) ResendCooldown() time.Duration {
+	if p.ResendCooldownSeconds <= 0 {
+		return time.Minute
+	}
+	return time.Duration(p.ResendCooldownSeconds) * time.Second
 }
 
 type Database struct {
@@ -44,8 +293,174 @@ type Resend struct {
 	FromEmail string `json:"from_email"`
 }
 
+// Mailer selects the email adapter: "resend" sends through the Resend API,
+// anything else uses the local adapter that writes to OutboxDir or the console.
+type Mailer struct {
+	Driver    string `json:"driver"`
+	OutboxDir string `json:"outbox_dir,omitempty"`
+}
+
+func (m Mailer) UsesResend() bool {
+	return m.Driver == "resend"
+}
+
+// LocalMediaPath is where the API serves the files of the local storage.
+const LocalMediaPath = "/media"
+
+// Storage selects the object storage adapter: "s3" uploads to an S3
+// compatible bucket, anything else uses the local adapter that writes under
+// LocalDir and is served by the API itself at LocalMediaPath. PublicBaseURL
+// is the prefix of the URLs handed to clients; it defaults to LocalMediaPath
+// locally and to the bucket URL for S3.
+type Storage struct {
+	Driver        string `json:"driver"`
+	LocalDir      string `json:"local_dir,omitempty"`
+	PublicBaseURL string `json:"public_base_url,omitempty"`
+	S3            S3     `json:"s3"`
+}
+
+func (s Storage) UsesS3() bool {
+	return s.Driver == "s3"
+}
+
+func (s Storage) LocalBaseURL() string {
+	if s.PublicBaseURL == "" {
+		return LocalMediaPath
+	}
+	return s.PublicBaseURL
+}
+
+func (s Storage) Directory() string {
+	if s.LocalDir == "" {
+		return "uploads"
+	}
+	return s.LocalDir
+}
+
+// S3 holds the bucket and credentials of an S3 compatible service such as
+// AWS S3, Cloudflare R2 or MinIO. UsePathStyle addresses the bucket as
+// Endpoint/Bucket instead of Bucket.Endpoint, as MinIO expects.
+type S3 struct {
+	Endpoint        string `json:"endpoint"`
+	Region          string `json:"region"`
+	Bucket          string `json:"bucket"`
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+	UsePathStyle    bool   `json:"use_path_style,omitempty"`
+}
+
+// ProfilePhoto limits uploaded photos and sets the sizes they are stored in.
+type ProfilePhoto struct {
+	MaxUploadBytes int64 `json:"max_upload_bytes,omitempty"`
+	MaxDimension   int   `json:"max_dimension,omitempty"`
+	ThumbnailSize  int   `json:"thumbnail_size,omitempty"`
+}
+
+func (p ProfilePhoto) MaxBytes() int64 {
+	if p.MaxUploadBytes <= 0 {
+		return 5 << 20
+	}
+	return p.MaxUploadBytes
+}
+
+func (p ProfilePhoto) Dimension() int {
+	if p.MaxDimension <= 0 {
+		return 1024
+	}
+	return p.MaxDimension
+}
+
+func (p ProfilePhoto) Thumbnail() int {
+	if p.ThumbnailSize <= 0 {
+		return 256
+	}
+	return p.ThumbnailSize
+}
+
+// SMS selects the SMS adapter: "twilio" sends through the Twilio API,
+// anything else keeps the messages in memory, which only suits development.
+type SMS struct {
+	Driver string `json:"driver"`
+	Twilio Twilio `json:"twilio"`
+}
+
+func (s SMS) UsesTwilio() bool {
+	return s.Driver == "twilio"
+}
+
+type Twilio struct {
+	AccountSID string `json:"account_sid"`
+	AuthToken  string `json:"auth_token"`
+	FromNumber string `json:"from_number"`
+}
+
+// JWTConfig selects how access tokens are signed. HS256 uses SecretKey.
+// RS256 and EdDSA sign with key pairs: Keys are loaded from configuration or
+// files, and rotated keys are stored encrypted with KeyEncryptionKey, a base64
+// encoded 32-byte key.
 type JWTConfig struct {
-	SecretKey string `json:"secret_key"`
+	SecretKey             string   `json:"secret_key"`
+	Issuer                string   `json:"issuer,omitempty"`
+	AccessTokenTTLMinutes int      `json:"access_token_ttl_minutes,omitempty"`
+	RefreshTokenTTLDays   int      `json:"refresh_token_ttl_days,omitempty"`
+	Algorithm             string   `json:"algorithm,omitempty"`
+	KeyEncryptionKey      string   `json:"key_encryption_key,omitempty"`
+	Keys                  []JWTKey `json:"keys,omitempty"`
+}
+
+// JWTKey is a key pair given inline as PEM or as paths to PEM files. A key
+// without a private part only verifies tokens.
+type JWTKey struct {
+	ID             string `json:"kid"`
+	PrivateKey     string `json:"private_key,omitempty"`
+	PrivateKeyFile string `json:"private_key_file,omitempty"`
+	PublicKey      string `json:"public_key,omitempty"`
+	PublicKeyFile  string `json:"public_key_file,omitempty"`
+}
+
+// Load returns the PEM contents of the key, reading the files if needed.
+func (k JWTKey) Load() (privateKey, publicKey string, err error) {
+	privateKey, err = readInlineOrFile(k.PrivateKey, k.PrivateKeyFile)
+	if err != nil {
+		return "", "", err
+	}
+
+	publicKey, err = readInlineOrFile(k.PublicKey, k.PublicKeyFile)
+	if err != nil {
+		return "", "", err
+	}
+
+	return privateKey, publicKey, nil
+}
+
+func readInlineOrFile(inline, path string) (string, error) {
+	if inline != "" || path == "" {
+		return inline, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading key file %s: %w", path, err)
+	}
+	return string(content), nil
+}
+
+func (j JWTConfig) UsesAsymmetricKeys() bool {
+	return j.Algorithm == "RS256" || j.Algorithm == "EdDSA"
+}
+
+func (j JWTConfig) AccessTokenTTL() time.Duration {
+	if j.AccessTokenTTLMinutes <= 0 {
+		return time.Hour
+	}
+	return time.Duration(j.AccessTokenTTLMinutes) * time.Minute
+}
+
+func (j JWTConfig) RefreshTokenTTL() time.Duration {
+	if j.RefreshTokenTTLDays <= 0 {
+		return 30 * 24 * time.Hour
+	}
+	return time.Duration(j.RefreshTokenTTLDays) * 24 * time.Hour
 }
 
 func LoadConfig() (*Config, error) {
@@ -112,13 +527,65 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("database driver is required")
 	}
 
-	
+	switch c.JWT.Algorithm {
+	case "", "HS256":
+		if c.JWT.SecretKey == "" {
+			return fmt.Errorf("jwt secret_key is required")
+		}
+	case "RS256", "EdDSA":
+		if c.JWT.KeyEncryptionKey == "" {
+			return fmt.Errorf("jwt key_encryption_key is required for %s", c.JWT.Algorithm)
+		}
+		for _, key := range c.JWT.Keys {
+			if key.ID == "" {
+				return fmt.Errorf("jwt keys require a kid")
+			}
+			if key.PrivateKey == "" && key.PrivateKeyFile == "" && key.PublicKey == "" && key.PublicKeyFile == "" {
+				return fmt.Errorf("jwt key %s has no key material", key.ID)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported jwt algorithm %s", c.JWT.Algorithm)
+	}
+
+	if c.Verification.BaseURL == "" {
+		return fmt.Errorf("verification base_url is required")
+	}
+
+	if c.PasswordReset.BaseURL == "" {
+		return fmt.Errorf("password_reset base_url is required")
+	}
+
+	if c.MFA.EncryptionKey == "" {
+		return fmt.Errorf("mfa encryption_key is required")
+	}
+
+	for _, provider := range c.OIDC.Providers {
+		if provider.Name == "" || provider.Issuer == "" || provider.ClientID == "" || provider.RedirectURL == "" {
+			return fmt.Errorf("oidc providers require name, issuer, client_id and redirect_url")
+		}
+	}
+
+	if c.Mailer.UsesResend() && (c.Resend.APIKey == "" || c.Resend.FromEmail == "") {
+		return fmt.Errorf("resend api_key and from_email are required when mailer driver is resend")
+	}
+
+	if c.SMS.UsesTwilio() && (c.SMS.Twilio.AccountSID == "" || c.SMS.Twilio.AuthToken == "" || c.SMS.Twilio.FromNumber == "") {
+		return fmt.Errorf("twilio account_sid, auth_token and from_number are required when sms driver is twilio")
+	}
+
+	if c.Storage.UsesS3() {
+		s3 := c.Storage.S3
+		if s3.Endpoint == "" || s3.Region == "" || s3.Bucket == "" || s3.AccessKeyID == "" || s3.SecretAccessKey == "" {
+			return fmt.Errorf("storage s3 endpoint, region, bucket, access_key_id and secret_access_key are required when storage driver is s3")
+		}
+	}
+
 	if c.Database.URL != "" {
 		slog.Debug("Using database URL connection string")
 		return nil
 	}
 
-	
 	requiredFields := map[string]string{
 		"host":     c.Database.Host,
 		"port":     c.Database.Port,
@@ -164,3 +631,6 @@ func (c *Config) IsProduction() bool {
 	return c.Environment == "production" || c.Environment == "railway"
 }
 
+func (c *Config) IsLocal() bool {
+	return c.Environment == "local"
+}
@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// AccountDeletion is a person's request to delete their account. The
+// account is anonymized once ScheduledFor has passed; until then the person
+// can cancel it.
+type AccountDeletion struct {
+	PersonID     string
+	RequestedAt  time.Time
+	ScheduledFor time.Time
+	CompletedAt  *time.Time
+}
+
+func (d AccountDeletion) IsDue(now time.Time) bool {
+	return d.CompletedAt == nil && !now.Before(d.ScheduledFor)
+}
+
+func (d AccountDeletion) IsCompleted() bool {
+	return d.CompletedAt != nil
+}
+
+// AnonymizedPerson returns the person with every personal field replaced.
+// The ID and role are kept so historical records still point at a valid
+// account; the email is unique per person and can never receive mail.
+func AnonymizedPerson(person Person) Person {
+	return Person{
+		ID:        person.ID,
+		FirstName: "Deleted",
+		LastName:  "User",
+		Email:     "deleted-" + person.ID + "@deleted.invalid",
+		Role:      person.Role,
+	}
+}
+
+// MFAStatus summarizes the second factor of a person without its secrets.
+type MFAStatus struct {
+	Enrolled    bool
+	ConfirmedAt *time.Time
+}
+
+// PersonDataExport is everything stored about a person, as returned to them
+// for a Habeas Data access request. Secrets such as password hashes, TOTP
+// seeds and token hashes are left out.
+type PersonDataExport struct {
+	GeneratedAt        time.Time
+	Person             Person
+	Sessions           []Session
+	ExternalIdentities []ExternalIdentity
+	MFA                MFAStatus
+	Deletion           *AccountDeletion
+}
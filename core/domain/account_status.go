@@ -0,0 +1,86 @@
+package domain
+
+import "time"
+
+const (
+	AccountStatusActive          = "active"
+	AccountStatusSuspended       = "suspended"
+	AccountStatusBanned          = "banned"
+	AccountStatusPendingDeletion = "pending_deletion"
+)
+
+// AdminAccountStatuses are the statuses an admin can set. Pending deletion
+// is only entered and left through the person's own deletion request.
+var AdminAccountStatuses = []string{AccountStatusActive, AccountStatusSuspended, AccountStatusBanned}
+
+func IsValidAccountStatus(status string) bool {
+	switch status {
+	case AccountStatusActive, AccountStatusSuspended, AccountStatusBanned, AccountStatusPendingDeletion:
+		return true
+	}
+	return false
+}
+
+// AccountStatusChange is a status set on an account with its reason. Only a
+// suspension may expire; without an expiry it lasts until lifted. SetByRole
+// is the role of the staff member who made the change, empty when the person
+// made it.
+type AccountStatusChange struct {
+	Status    string
+	Reason    string
+	ExpiresAt *time.Time
+	SetByRole string
+}
+
+// Validate checks a change made by an admin.
+func (c AccountStatusChange) Validate(now time.Time) error {
+	isAdminStatus := false
+	for _, status := range AdminAccountStatuses {
+		if c.Status == status {
+			isAdminStatus = true
+		}
+	}
+	if !isAdminStatus {
+		return ErrInvalidAccountStatus
+	}
+
+	if c.ExpiresAt != nil && (c.Status != AccountStatusSuspended || !c.ExpiresAt.After(now)) {
+		return ErrInvalidStatusExpiry
+	}
+
+	return nil
+}
+
+// CurrentStatus is the status in force at now. A suspension whose expiry has
+// passed no longer applies.
+func (u Person) CurrentStatus(now time.Time) string {
+	if u.Status == "" {
+		return AccountStatusActive
+	}
+	if u.Status == AccountStatusSuspended && u.StatusExpiresAt != nil && !now.Before(*u.StatusExpiresAt) {
+		return AccountStatusActive
+	}
+	return u.Status
+}
+
+// CanOverrideStatus reports whether a caller with the actor role may replace
+// the status in force at now. A decision taken by a higher role can only be
+// changed by that role or above, so support cannot lift an admin's ban.
+func (u Person) CanOverrideStatus(actor string, now time.Time) bool {
+	if u.CurrentStatus(now) == AccountStatusActive {
+		return true
+	}
+	return roleRanks[u.StatusSetByRole] <= roleRanks[actor]
+}
+
+// CheckAccountStatus fails for accounts that must not log in or call the
+// API. Accounts pending deletion keep access so they can cancel it.
+func (u Person) CheckAccountStatus(now time.Time) error {
+	switch u.CurrentStatus(now) {
+	case AccountStatusSuspended:
+		return ErrAccountSuspended
+	case AccountStatusBanned:
+		return ErrAccountBanned
+	}
+	return nil
+}
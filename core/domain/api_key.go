@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// Scopes that can be granted to API keys.
+const (
+	ScopePersonsRead  = "persons:read"
+	ScopePersonsWrite = "persons:write"
+	ScopeRidesRead    = "rides:read"
+	ScopeRidesWrite   = "rides:write"
+)
+
+var apiKeyScopes = []string{ScopePersonsRead, ScopePersonsWrite, ScopeRidesRead, ScopeRidesWrite}
+
+func IsValidScope(scope string) bool {
+	return slices.Contains(apiKeyScopes, scope)
+}
+
+// APIKey lets a service call the API without a person logging in. Only the
+// hash of the key is stored; Prefix is kept to tell keys apart in listings.
+type APIKey struct {
+	ID         string
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	CreatedBy  string
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	RevokedAt  *time.Time
+	LastUsedAt *time.Time
+}
+
+func (k APIKey) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+func (k APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+func (k APIKey) Principal() Principal {
+	return Principal{
+		Type:   PrincipalAPIKey,
+		ID:     k.ID,
+		Scopes: k.Scopes,
+	}
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// emailProvider holds the addressing rules of a mail provider: which domains
+// are the same mailbox, whether dots in the local part are ignored and
+// whether a "+tag" suffix is delivered to the same mailbox.
+type emailProvider struct {
+	domain     string
+	ignoreDots bool
+	plusTags   bool
+}
+
+var emailProviders = map[string]emailProvider{
+	"gmail.com":      {domain: "gmail.com", ignoreDots: true, plusTags: true},
+	"googlemail.com": {domain: "gmail.com", ignoreDots: true, plusTags: true},
+	"outlook.com":    {domain: "outlook.com", plusTags: true},
+	"hotmail.com":    {domain: "hotmail.com", plusTags: true},
+	"live.com":       {domain: "live.com", plusTags: true},
+}
+
+// NormalizeEmail trims the address and lowercases its domain. It is the form
+// shown back to the person.
+func NormalizeEmail(email string) string {
+	email = strings.TrimSpace(email)
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return email
+	}
+	return email[:at+1] + strings.ToLower(email[at+1:])
+}
+
+// CanonicalEmail is the identity of an address: two addresses with the same
+// canonical form reach the same mailbox and belong to one account. The local
+// part is compared case-insensitively and known providers' dot and "+tag"
+// rules are applied, except to local parts starting with "+". The backfill
+// in migration 0010 applies the same rules and must keep agreeing with them.
+func CanonicalEmail(email string) string {
+	email = strings.ToLower(NormalizeEmail(email))
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return email
+	}
+
+	local, emailDomain := email[:at], email[at+1:]
+	provider, ok := emailProviders[emailDomain]
+	if !ok || strings.HasPrefix(local, "+") {
+		return email
+	}
+
+	if provider.plusTags {
+		local, _, _ = strings.Cut(local, "+")
+	}
+	if provider.ignoreDots {
+		local = strings.ReplaceAll(local, ".", "")
+	}
+
+	return local + "@" + provider.domain
+}
+
+// EmailAccount is the email of one person, as stored.
+type EmailAccount struct {
+	PersonID string
+	Email    string
+}
+
+// EmailCollision groups the accounts whose emails share a canonical form.
+type EmailCollision struct {
+	CanonicalEmail string
+	Accounts       []EmailAccount
+}
+
+// FindEmailCollisions reports the canonical emails used by more than one
+// account, sorted by canonical email.
+func FindEmailCollisions(accounts []EmailAccount) []EmailCollision {
+	byCanonical := make(map[string][]EmailAccount)
+	for _, account := range accounts {
+		canonical := CanonicalEmail(account.Email)
+		byCanonical[canonical] = append(byCanonical[canonical], account)
+	}
+
+	collisions := []EmailCollision{}
+	for canonical, group := range byCanonical {
+		if len(group) > 1 {
+			collisions = append(collisions, EmailCollision{CanonicalEmail: canonical, Accounts: group})
+		}
+	}
+	sort.Slice(collisions, func(i, j int) bool {
+		return collisions[i].CanonicalEmail < collisions[j].CanonicalEmail
+	})
+
+	return collisions
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "testing"
+
+// The expected values are also what the backfill in migration 0010 stores,
+// so existing rows and new registrations agree on the canonical email.
+func TestCanonicalEmail(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+		want  string
+	}{
+		{"gmail dots", "ana.maria.rider@gmail.com", "anamariarider@gmail.com"},
+		{"gmail tag", "ana+motogo@gmail.com", "ana@gmail.com"},
+		{"gmail dots and tag", "a.n.a+moto.go@gmail.com", "ana@gmail.com"},
+		{"googlemail", "ana.rider@googlemail.com", "anarider@gmail.com"},
+		{"gmail leading plus", "+a.na@gmail.com", "+a.na@gmail.com"},
+		{"outlook tag", "ana+motogo@outlook.com", "ana@outlook.com"},
+		{"outlook keeps dots", "ana.rider@outlook.com", "ana.rider@outlook.com"},
+		{"hotmail tag", "ana.rider+motogo@hotmail.com", "ana.rider@hotmail.com"},
+		{"live tag", "ana+a+b@live.com", "ana@live.com"},
+		{"outlook leading plus", "+ana@outlook.com", "+ana@outlook.com"},
+		{"domain case", "Ana.Rider+Tag@GMail.COM", "anarider@gmail.com"},
+		{"local part case", "Ana.Rider@Example.COM", "ana.rider@example.com"},
+		{"other domain keeps dots and tags", "ana.rider+motogo@example.com", "ana.rider+motogo@example.com"},
+		{"surrounding spaces", "  Ana@Example.com ", "ana@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanonicalEmail(tt.email); got != tt.want {
+				t.Errorf("CanonicalEmail(%q) = %q, want %q", tt.email, got, tt.want)
+			}
+		})
+	}
+}
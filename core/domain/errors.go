@@ -7,6 +7,23 @@ var (
 	ErrUserCannotSave = errors.New("user cannot be saved")
 	ErrPersonNotFound = errors.New("person not found")
 
+	ErrAccountSuspended     = errors.New("account is suspended")
+	ErrAccountBanned        = errors.New("account is banned")
+	ErrInvalidAccountStatus = errors.New("invalid account status")
+	ErrInvalidStatusExpiry  = errors.New("only suspensions can expire, and the expiry must be in the future")
+
+	ErrInvalidDocumentType       = errors.New("invalid identity document type")
+	ErrInvalidIdentityNumber     = errors.New("identity number is not valid for the document type")
+	ErrDuplicateIdentityDocument = errors.New("identity document already registered")
+
+	ErrInvalidPhoneNumber   = errors.New("phone number is not a valid mobile number")
+	ErrUnsupportedCountry   = errors.New("country is not supported")
+	ErrDuplicatePhoneNumber = errors.New("phone number already registered")
+
+	ErrInvalidPersonFilter = errors.New("invalid person list filter")
+	ErrInvalidSortKey      = errors.New("invalid sort key")
+	ErrInvalidCursor       = errors.New("invalid pagination cursor")
+
 	ErrGettingUserByEmail        = errors.New("error getting user by email")
 	ErrNotFoundUserByEmail       = errors.New("user not found by email")
 	ErrUserCannotFound           = errors.New("user cannot be found")
@@ -16,5 +33,91 @@ var (
 	ErrTokenExpired              = errors.New("token expired")
 	ErrTokenAlreadyUsed          = errors.New("token already used")
 
+	ErrVerificationTokenCannotSave = errors.New("verification token cannot be saved")
+	ErrVerificationTokenCannotGet  = errors.New("verification token cannot be retrieved")
+	ErrEmailCannotSend             = errors.New("email cannot be sent")
+
+	ErrPhoneNotVerified     = errors.New("phone number not verified")
+	ErrPhoneAlreadyVerified = errors.New("phone number already verified")
+	ErrOTPNotFound          = errors.New("verification code not found")
+	ErrInvalidOTP           = errors.New("invalid verification code")
+	ErrOTPAttemptsExceeded  = errors.New("too many verification attempts")
+	ErrOTPResendCooldown    = errors.New("verification code was sent recently, try again later")
+	ErrOTPCannotSave        = errors.New("verification code cannot be saved")
+	ErrOTPCannotGet         = errors.New("verification code cannot be retrieved")
+	ErrSMSCannotSend        = errors.New("sms cannot be sent")
+
+	ErrInvalidCredentials      = errors.New("invalid email or password")
+	ErrInvalidCurrentPassword  = errors.New("current password is incorrect")
+	ErrWeakPassword            = errors.New("password does not meet the password policy")
+	ErrTooManyLoginAttempts    = errors.New("too many login attempts, try again later")
+	ErrAccountLocked           = errors.New("account temporarily locked after too many failed logins")
+	ErrTooManyEmailRequests    = errors.New("too many email requests, try again later")
+	ErrLockoutTargetRequired   = errors.New("email or ip is required")
+	ErrLoginAttemptsCannotGet  = errors.New("login attempts cannot be retrieved")
+	ErrLoginAttemptsCannotSave = errors.New("login attempts cannot be saved")
+	ErrInvalidToken            = errors.New("invalid or expired token")
+	ErrTokenCannotCreate       = errors.New("token cannot be created")
+
+	ErrRefreshTokenNotFound   = errors.New("refresh token not found")
+	ErrRefreshTokenReused     = errors.New("refresh token reuse detected")
+	ErrRefreshTokenCannotSave = errors.New("refresh token cannot be saved")
+	ErrRefreshTokenCannotGet  = errors.New("refresh token cannot be retrieved")
+
+	ErrOIDCProviderNotFound       = errors.New("identity provider not found")
+	ErrOIDCInvalidState           = errors.New("invalid or expired login state")
+	ErrOIDCExchangeFailed         = errors.New("identity provider login failed")
+	ErrOIDCEmailNotVerified       = errors.New("identity provider did not verify the email")
+	ErrOIDCAccountNotLinkable     = errors.New("an account with this email exists but its email is not verified")
+	ErrExternalIdentityNotFound   = errors.New("external identity not found")
+	ErrExternalIdentityCannotSave = errors.New("external identity cannot be saved")
+	ErrExternalIdentityCannotGet  = errors.New("external identity cannot be retrieved")
+
+	ErrAccountDeletionNotFound         = errors.New("account deletion request not found")
+	ErrAccountDeletionAlreadyRequested = errors.New("account deletion already requested")
+	ErrAccountDeletionCannotSave       = errors.New("account deletion cannot be saved")
+	ErrAccountDeletionCannotGet        = errors.New("account deletion cannot be retrieved")
+	ErrAccountDeletionPending          = errors.New("account has a pending deletion request")
+
+	ErrVersionRequired = errors.New("the current version of the resource is required, send it in If-Match")
+	ErrVersionConflict = errors.New("the resource was modified since it was read")
+
+	ErrPhotoRequired        = errors.New("photo file is required")
+	ErrPhotoTooLarge        = errors.New("photo exceeds the maximum upload size")
+	ErrUnsupportedPhotoType = errors.New("photo must be a JPEG or PNG image")
+	ErrInvalidPhoto         = errors.New("photo cannot be decoded")
+	ErrPhotoNotFound        = errors.New("person has no profile photo")
+	ErrProfilePhotoRequired = errors.New("a profile photo is required for this role")
+	ErrPhotoCannotStore     = errors.New("photo cannot be stored")
+
+	ErrAPIKeyNotFound     = errors.New("api key not found")
+	ErrInvalidAPIKey      = errors.New("invalid, expired or revoked api key")
+	ErrInvalidScope       = errors.New("invalid api key scope")
+	ErrAPIKeyExpiryInPast = errors.New("api key expiry must be in the future")
+	ErrAPIKeyCannotSave   = errors.New("api key cannot be saved")
+	ErrAPIKeyCannotGet    = errors.New("api key cannot be retrieved")
+
+	ErrSigningKeyCannotSave          = errors.New("signing key cannot be saved")
+	ErrSigningKeyCannotGet           = errors.New("signing key cannot be retrieved")
+	ErrSigningKeyRotationUnavailable = errors.New("signing key rotation requires an asymmetric jwt algorithm")
+
+	ErrSessionNotFound   = errors.New("session not found")
+	ErrSessionRevoked    = errors.New("session has been revoked")
+	ErrSessionCannotSave = errors.New("session cannot be saved")
+	ErrSessionCannotGet  = errors.New("session cannot be retrieved")
+
+	ErrMFAFactorNotFound      = errors.New("two-factor authentication is not enrolled")
+	ErrMFAAlreadyEnrolled     = errors.New("two-factor authentication is already enrolled")
+	ErrInvalidMFACode         = errors.New("invalid two-factor authentication code")
+	ErrMFANotAvailableForRole = errors.New("two-factor authentication is not available for this role")
+	ErrMFARequiredByPolicy    = errors.New("two-factor authentication is required for this role")
+	ErrMFACannotSave          = errors.New("two-factor authentication cannot be saved")
+	ErrMFACannotGet           = errors.New("two-factor authentication cannot be retrieved")
+
+	ErrInvalidRole        = errors.New("invalid role")
+	ErrRoleNotAllowed     = errors.New("role cannot be self-assigned")
+	ErrRoleOutranksCaller = errors.New("account has a higher role than the caller")
+	ErrStatusSetByHigher  = errors.New("account status was set by a higher role")
+
 	ErrInvalidJSONFormat = errors.New("invalid JSON format")
-)
\ No newline at end of file
+)
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// ExternalIdentity links an account at an OpenID Connect provider to a person.
+type ExternalIdentity struct {
+	Provider  string
+	Subject   string
+	PersonID  string
+	Email     string
+	CreatedAt time.Time
+}
+
+// OIDCLoginState is kept between sending the user to the provider and the
+// callback. It is single-use and short-lived.
+type OIDCLoginState struct {
+	State        string
+	Provider     string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+func (s OIDCLoginState) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
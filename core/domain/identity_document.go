@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Colombian identity document types.
+const (
+	DocumentTypeCC  = "CC"  // Cédula de ciudadanía
+	DocumentTypeCE  = "CE"  // Cédula de extranjería
+	DocumentTypeTI  = "TI"  // Tarjeta de identidad
+	DocumentTypePA  = "PA"  // Pasaporte
+	DocumentTypePPT = "PPT" // Permiso por protección temporal
+	DocumentTypeNIT = "NIT" // Número de identificación tributaria
+
+	DefaultDocumentType = DocumentTypeCC
+)
+
+var documentNumberPatterns = map[string]*regexp.Regexp{
+	DocumentTypeCC:  regexp.MustCompile(`^[1-9][0-9]{2,9}$`),
+	DocumentTypeCE:  regexp.MustCompile(`^[0-9]{3,10}$`),
+	DocumentTypeTI:  regexp.MustCompile(`^[1-9][0-9]{9,10}$`),
+	DocumentTypePA:  regexp.MustCompile(`^[A-Z0-9]{5,20}$`),
+	DocumentTypePPT: regexp.MustCompile(`^[0-9]{5,10}$`),
+	DocumentTypeNIT: regexp.MustCompile(`^[0-9]{6,15}$`),
+}
+
+// nitWeights are the DIAN weights for the NIT check digit, applied from the
+// rightmost digit of the number.
+var nitWeights = []int{3, 7, 13, 17, 19, 23, 29, 37, 41, 43, 47, 53, 59, 67, 71}
+
+func IsValidDocumentType(documentType string) bool {
+	_, ok := documentNumberPatterns[documentType]
+	return ok
+}
+
+// NormalizeIdentityNumber removes the formatting people type around document
+// numbers. A NIT keeps its check digit as the last digit, without the dash.
+func NormalizeIdentityNumber(documentType, number string) string {
+	number = strings.ToUpper(strings.TrimSpace(number))
+	number = strings.NewReplacer(" ", "", ".", "").Replace(number)
+	if documentType == DocumentTypeNIT {
+		number = strings.ReplaceAll(number, "-", "")
+	}
+	return number
+}
+
+// ValidateIdentityDocument checks a normalized document number against the
+// format of its type and, for a NIT, its check digit.
+func ValidateIdentityDocument(documentType, number string) error {
+	pattern, ok := documentNumberPatterns[documentType]
+	if !ok {
+		return ErrInvalidDocumentType
+	}
+
+	if !pattern.MatchString(number) {
+		return ErrInvalidIdentityNumber
+	}
+
+	if documentType == DocumentTypeNIT {
+		base, checkDigit := number[:len(number)-1], int(number[len(number)-1]-'0')
+		if NITCheckDigit(base) != checkDigit {
+			return ErrInvalidIdentityNumber
+		}
+	}
+
+	return nil
+}
+
+// NITCheckDigit computes the DIAN check digit of a NIT without its check
+// digit. base must contain only digits.
+func NITCheckDigit(base string) int {
+	sum := 0
+	for i := 0; i < len(base) && i < len(nitWeights); i++ {
+		digit := int(base[len(base)-1-i] - '0')
+		sum += digit * nitWeights[i]
+	}
+
+	remainder := sum % 11
+	if remainder > 1 {
+		return 11 - remainder
+	}
+	return remainder
+}
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNITCheckDigit(t *testing.T) {
+	// Published NITs of Colombian companies and entities.
+	tests := []struct {
+		name string
+		base string
+		want int
+	}{
+		{"Bancolombia", "890903938", 8},
+		{"Ecopetrol", "899999068", 1},
+		{"DIAN", "800197268", 4},
+		{"Almacenes Éxito", "890900608", 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NITCheckDigit(tt.base); got != tt.want {
+				t.Errorf("NITCheckDigit(%q) = %d, want %d", tt.base, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateIdentityDocument(t *testing.T) {
+	tests := []struct {
+		name         string
+		documentType string
+		number       string
+		want         error
+	}{
+		{"valid NIT", DocumentTypeNIT, "8909039388", nil},
+		{"NIT with wrong check digit", DocumentTypeNIT, "8909039381", ErrInvalidIdentityNumber},
+		{"NIT too short", DocumentTypeNIT, "12345", ErrInvalidIdentityNumber},
+		{"valid CC", DocumentTypeCC, "1020304050", nil},
+		{"CC with leading zero", DocumentTypeCC, "0123456", ErrInvalidIdentityNumber},
+		{"CC too long", DocumentTypeCC, "12345678901", ErrInvalidIdentityNumber},
+		{"valid CE", DocumentTypeCE, "012345", nil},
+		{"CE with letters", DocumentTypeCE, "12A456", ErrInvalidIdentityNumber},
+		{"valid TI", DocumentTypeTI, "1012345678", nil},
+		{"TI too short", DocumentTypeTI, "101234567", ErrInvalidIdentityNumber},
+		{"valid PA", DocumentTypePA, "AB123456", nil},
+		{"PA with lowercase", DocumentTypePA, "ab123456", ErrInvalidIdentityNumber},
+		{"PA too short", DocumentTypePA, "AB12", ErrInvalidIdentityNumber},
+		{"valid PPT", DocumentTypePPT, "1234567", nil},
+		{"PPT with letters", DocumentTypePPT, "PPT1234", ErrInvalidIdentityNumber},
+		{"unknown type", "RC", "1234567", ErrInvalidDocumentType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateIdentityDocument(tt.documentType, tt.number); !errors.Is(err, tt.want) {
+				t.Errorf("ValidateIdentityDocument(%q, %q) = %v, want %v", tt.documentType, tt.number, err, tt.want)
+			}
+		})
+	}
+}
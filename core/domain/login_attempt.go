@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+func LoginAttemptEmailKey(email string) string {
+	return "email:" + CanonicalEmail(email)
+}
+
+func LoginAttemptIPKey(ip string) string {
+	return "ip:" + ip
+}
+
+// LoginAttemptMFAKey counts failed second-factor codes for a person.
+func LoginAttemptMFAKey(personID string) string {
+	return "mfa:" + personID
+}
+
+// PasswordResetEmailKey and PasswordResetIPKey count password reset requests
+// in the login attempt store, apart from failed logins.
+func PasswordResetEmailKey(email string) string {
+	return "reset:" + LoginAttemptEmailKey(email)
+}
+
+func PasswordResetIPKey(ip string) string {
+	return "reset:" + LoginAttemptIPKey(ip)
+}
+
+// VerificationResendKey counts verification email resends per address.
+func VerificationResendKey(email string) string {
+	return "verify:" + LoginAttemptEmailKey(email)
+}
+
+// RetryAfterError wraps a throttling error with the time the client should
+// wait before trying again.
+type RetryAfterError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// Two-factor authentication is offered to the roles that handle money or
+// personal data.
+var MFARoles = []string{RoleAdmin, RoleDriver}
+
+func SupportsMFA(role string) bool {
+	return slices.Contains(MFARoles, role)
+}
+
+type MFAFactor struct {
+	PersonID     string
+	Secret       string
+	LastUsedStep int64
+	CreatedAt    time.Time
+	ConfirmedAt  *time.Time
+}
+
+func (f MFAFactor) IsConfirmed() bool {
+	return f.ConfirmedAt != nil
+}
+
+type MFARecoveryCode struct {
+	ID        string
+	PersonID  string
+	CodeHash  string
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
+
+type MFARolePolicy struct {
+	Role     string `json:"role"`
+	Required bool   `json:"required"`
+}
+
+type MFAEnrollment struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// LoginResult is either a full set of tokens or, when a second factor is
+// needed, a short-lived MFA token to continue the login with.
+type LoginResult struct {
+	Tokens                *AuthTokens
+	MFARequired           bool
+	MFAEnrollmentRequired bool
+	MFAToken              string
+	MFAExpiresIn          int64
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"bufio"
+	_ "embed"
+	"strings"
+	"unicode"
+)
+
+//go:embed common_passwords.txt
+var commonPasswordsFile string
+
+var commonPasswords = loadCommonPasswords(commonPasswordsFile)
+
+// PasswordPolicyError lists every rule a password breaks, keyed by the request
+// field that carried it, in the same shape as schema validation errors.
+type PasswordPolicyError struct {
+	Violations map[string]string
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return ErrWeakPassword.Error()
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrWeakPassword
+}
+
+// ValidatePassword checks password against the policy for person. field is
+// the request property reported back to the client.
+func ValidatePassword(field, password string, person Person) error {
+	var problems []string
+
+	lowered := strings.ToLower(password)
+
+	if _, common := commonPasswords[lowered]; common {
+		problems = append(problems, "password is too common")
+	}
+
+	if !hasLetterAndDigit(password) {
+		problems = append(problems, "password must contain letters and numbers")
+	}
+
+	email := strings.ToLower(strings.TrimSpace(person.Email))
+	localPart, _, _ := strings.Cut(email, "@")
+	if email != "" && (strings.Contains(lowered, email) || (len(localPart) >= 3 && strings.Contains(lowered, localPart))) {
+		problems = append(problems, "password must not contain the email")
+	}
+
+	identity := strings.ToLower(strings.TrimSpace(person.IdentityNumber))
+	if identity != "" && strings.Contains(lowered, identity) {
+		problems = append(problems, "password must not contain the identity number")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return &PasswordPolicyError{
+		Violations: map[string]string{
+			field: strings.Join(problems, "; "),
+		},
+	}
+}
+
+func hasLetterAndDigit(password string) bool {
+	var hasLetter, hasDigit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	return hasLetter && hasDigit
+}
+
+func loadCommonPasswords(contents string) map[string]struct{} {
+	passwords := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(strings.NewReader(contents))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords[strings.ToLower(line)] = struct{}{}
+	}
+
+	return passwords
+}
@@ -1,33 +1,130 @@
 package domain
 
 import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/ports/password"
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
 )
 
 type Person struct {
-	ID                  string `json:"id"`
-	IdentityNumber      string `json:"identity_number"`
-	FirstName           string `json:"first_name"`
-	LastName            string `json:"last_name"`
-	SecondLastName      string `json:"second_last_name"`
-	Email               string `json:"email"`
-	PhoneNumber         string `json:"phone_number"`
-	EmailVerified       bool   `json:"email_verified"`
-	PhoneNumberVerified bool   `json:"phone_number_verified"`
-	Password            string `json:"-"`
-	Role                string `json:"role"`
+	ID                  string     `json:"id"`
+	DocumentType        string     `json:"document_type"`
+	IdentityNumber      string     `json:"identity_number"`
+	FirstName           string     `json:"first_name"`
+	LastName            string     `json:"last_name"`
+	SecondLastName      string     `json:"second_last_name"`
+	Email               string     `json:"email"`
+	PhoneNumber         string     `json:"phone_number"`
+	Country             string     `json:"country"`
+	EmailVerified       bool       `json:"email_verified"`
+	PhoneNumberVerified bool       `json:"phone_number_verified"`
+	Password            string     `json:"-"`
+	Role                string     `json:"role"`
+	Status              string     `json:"status"`
+	StatusReason        string     `json:"status_reason,omitempty"`
+	StatusExpiresAt     *time.Time `json:"status_expires_at,omitempty"`
+	StatusSetByRole     string     `json:"-"`
+	PhotoKey            string     `json:"-"`
+	PhotoURL            string     `json:"photo_url,omitempty"`
+	PhotoThumbnailURL   string     `json:"photo_thumbnail_url,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	DeletedAt           *time.Time `json:"-"`
+	// Version counts the writes to the person. Updates must name the version
+	// they were based on, so concurrent edits cannot silently overwrite each
+	// other.
+	Version int64 `json:"-"`
+}
+
+// PersonUpdate holds the profile fields a person can change. Nil fields are
+// left as they are.
+type PersonUpdate struct {
+	FirstName      *string
+	LastName       *string
+	SecondLastName *string
+	Email          *string
+	PhoneNumber    *string
+	// Country is the country a national phone number is read in. It is only
+	// used together with PhoneNumber.
+	Country *string
+}
+
+// Apply copies the update into the person. A new email or phone number is
+// no longer verified; the reported flags tell which of them changed.
+func (u *Person) Apply(update PersonUpdate) (emailChanged, phoneChanged bool) {
+	if update.FirstName != nil {
+		u.FirstName = *update.FirstName
+	}
+	if update.LastName != nil {
+		u.LastName = *update.LastName
+	}
+	if update.SecondLastName != nil {
+		u.SecondLastName = *update.SecondLastName
+	}
+	if update.Email != nil && *update.Email != u.Email {
+		if CanonicalEmail(*update.Email) != CanonicalEmail(u.Email) {
+			u.EmailVerified = false
+			emailChanged = true
+		}
+		u.Email = *update.Email
+	}
+	if update.PhoneNumber != nil && *update.PhoneNumber != u.PhoneNumber {
+		u.PhoneNumber = *update.PhoneNumber
+		u.PhoneNumberVerified = false
+		phoneChanged = true
+		if update.Country != nil {
+			u.Country = *update.Country
+		}
+	}
+	return emailChanged, phoneChanged
+}
+
+// NormalizePhoneNumber stores the phone number in E.164 and sets the country
+// it belongs to. A national number is read in the person's country.
+func (u *Person) NormalizePhoneNumber() error {
+	phone, err := NormalizePhoneNumber(u.PhoneNumber, u.Country)
+	if err != nil {
+		return err
+	}
+	u.PhoneNumber = phone.E164
+	u.Country = phone.Country
+	return nil
 }
 
 func (u *Person) SetID() {
 	u.ID = uuid.New().String()
 }
 
-func (u *Person) HashPassword() error {
-	hash, err := bcrypt.GenerateFromPassword([]byte(u.Password), bcrypt.DefaultCost)
+// MarkCreated sets the timestamps and the first version of a new person.
+func (u *Person) MarkCreated(now time.Time) {
+	u.CreatedAt = now
+	u.UpdatedAt = now
+	u.Version = 1
+}
+
+// Touch records a write to the person, as the repository does for every
+// update of the row.
+func (u *Person) Touch(now time.Time) {
+	u.UpdatedAt = now
+	u.Version++
+}
+
+func (u *Person) HashPassword(hasher password.Hasher) error {
+	hash, err := hasher.Hash(u.Password)
 	if err != nil {
 		return err
 	}
-	u.Password = string(hash)
+	u.Password = hash
 	return nil
-}
\ No newline at end of file
+}
+
+// CheckPassword reports whether plain matches the stored hash and whether the
+// hash should be upgraded to the hasher's current scheme.
+func (u *Person) CheckPassword(hasher password.Hasher, plain string) (bool, bool) {
+	match, needsRehash, err := hasher.Verify(u.Password, plain)
+	if err != nil {
+		return false, false
+	}
+	return match, needsRehash
+}
@@ -0,0 +1,91 @@
+package domain
+
+import "strings"
+
+const (
+	DefaultPersonPageSize = 20
+	MaxPersonPageSize     = 100
+
+	PersonSortFirstName = "first_name"
+	PersonSortLastName  = "last_name"
+	PersonSortEmail     = "email"
+
+	DefaultPersonSort = PersonSortLastName
+)
+
+var personSortFields = []string{PersonSortFirstName, PersonSortLastName, PersonSortEmail}
+
+// PersonFilter narrows a person listing. Empty fields do not filter.
+type PersonFilter struct {
+	Role                string
+	Status              string
+	EmailVerified       *bool
+	PhoneNumberVerified *bool
+	NamePrefix          string
+	DocumentType        string
+	IdentityNumber      string
+	PhoneNumber         string
+	// IncludeDeleted also lists soft-deleted persons, which are hidden by
+	// default.
+	IncludeDeleted bool
+}
+
+// PersonSort orders a listing by one field. The person ID breaks ties so the
+// order is total and keyset pagination never skips or repeats a row.
+type PersonSort struct {
+	Field      string
+	Descending bool
+}
+
+// ParsePersonSort reads a sort key such as "last_name" or "-email", where a
+// leading minus means descending.
+func ParsePersonSort(key string) (PersonSort, error) {
+	if key == "" {
+		return PersonSort{Field: DefaultPersonSort}, nil
+	}
+
+	sort := PersonSort{Field: strings.TrimPrefix(key, "-"), Descending: strings.HasPrefix(key, "-")}
+	for _, field := range personSortFields {
+		if sort.Field == field {
+			return sort, nil
+		}
+	}
+	return PersonSort{}, ErrInvalidSortKey
+}
+
+func (s PersonSort) Key() string {
+	if s.Descending {
+		return "-" + s.Field
+	}
+	return s.Field
+}
+
+// ValueOf returns the value of the sort field for a person.
+func (s PersonSort) ValueOf(person Person) string {
+	switch s.Field {
+	case PersonSortFirstName:
+		return person.FirstName
+	case PersonSortEmail:
+		return person.Email
+	default:
+		return person.LastName
+	}
+}
+
+// PersonCursor is the position after the last person of a page.
+type PersonCursor struct {
+	Value string
+	ID    string
+}
+
+type PersonQuery struct {
+	Filter PersonFilter
+	Sort   PersonSort
+	After  *PersonCursor
+	Limit  int
+}
+
+type PersonPage struct {
+	Persons    []Person
+	NextCursor string
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Supported countries, as ISO 3166-1 alpha-2 codes.
+const (
+	CountryColombia = "CO"
+	CountryEcuador  = "EC"
+	CountryPeru     = "PE"
+
+	DefaultCountry = CountryColombia
+)
+
+// phoneCountry describes the mobile numbers of a country. OTPs go out by
+// SMS, so only mobile numbers are accepted.
+type phoneCountry struct {
+	code          string
+	callingCode   string
+	mobilePattern *regexp.Regexp
+}
+
+var phoneCountries = []phoneCountry{
+	{code: CountryColombia, callingCode: "57", mobilePattern: regexp.MustCompile(`^3[0-9]{9}$`)},
+	{code: CountryEcuador, callingCode: "593", mobilePattern: regexp.MustCompile(`^9[0-9]{8}$`)},
+	{code: CountryPeru, callingCode: "51", mobilePattern: regexp.MustCompile(`^9[0-9]{8}$`)},
+}
+
+// PhoneNumber is a mobile number in E.164 form and the country it belongs to.
+type PhoneNumber struct {
+	E164    string
+	Country string
+}
+
+func IsSupportedCountry(country string) bool {
+	_, ok := findPhoneCountry(country)
+	return ok
+}
+
+// NormalizePhoneNumber turns the ways people write a mobile number into
+// E.164. Numbers with a "+" or "00" prefix carry their own country; national
+// numbers, with or without a trunk "0" or the calling code, are read in the
+// given country. "+57 300 123 4567", "3001234567" and "0573001234567" all
+// become "+573001234567".
+func NormalizePhoneNumber(raw, country string) (PhoneNumber, error) {
+	if country == "" {
+		country = DefaultCountry
+	}
+	home, ok := findPhoneCountry(country)
+	if !ok {
+		return PhoneNumber{}, ErrUnsupportedCountry
+	}
+
+	raw = strings.TrimSpace(raw)
+	international := strings.HasPrefix(raw, "+")
+	digits := strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "").Replace(strings.TrimPrefix(raw, "+"))
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return PhoneNumber{}, ErrInvalidPhoneNumber
+	}
+
+	if strings.HasPrefix(digits, "00") {
+		digits, international = digits[2:], true
+	}
+
+	if international {
+		for _, candidate := range phoneCountries {
+			if national, ok := candidate.national(digits); ok {
+				return candidate.phoneNumber(national), nil
+			}
+		}
+		return PhoneNumber{}, ErrInvalidPhoneNumber
+	}
+
+	candidates := []string{digits}
+	if trimmed := strings.TrimPrefix(digits, "0"); trimmed != digits {
+		candidates = append(candidates, trimmed)
+	}
+	for _, candidate := range candidates {
+		if home.mobilePattern.MatchString(candidate) {
+			return home.phoneNumber(candidate), nil
+		}
+		if national, ok := home.national(candidate); ok {
+			return home.phoneNumber(national), nil
+		}
+	}
+
+	return PhoneNumber{}, ErrInvalidPhoneNumber
+}
+
+// national strips the calling code from digits and reports whether the rest
+// is a mobile number of the country.
+func (c phoneCountry) national(digits string) (string, bool) {
+	if !strings.HasPrefix(digits, c.callingCode) {
+		return "", false
+	}
+	national := digits[len(c.callingCode):]
+	return national, c.mobilePattern.MatchString(national)
+}
+
+func (c phoneCountry) phoneNumber(national string) PhoneNumber {
+	return PhoneNumber{E164: "+" + c.callingCode + national, Country: c.code}
+}
+
+func findPhoneCountry(country string) (phoneCountry, bool) {
+	for _, candidate := range phoneCountries {
+		if candidate.code == country {
+			return candidate, true
+		}
+	}
+	return phoneCountry{}, false
+}
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+type PhoneOTP struct {
+	ID          string
+	PersonID    string
+	PhoneNumber string
+	CodeHash    string
+	Attempts    int
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+	ConsumedAt  *time.Time
+}
+
+func (o PhoneOTP) IsExpired(now time.Time) bool {
+	return !now.Before(o.ExpiresAt)
+}
+
+func (o PhoneOTP) IsConsumed() bool {
+	return o.ConsumedAt != nil
+}
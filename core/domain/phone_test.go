@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		country string
+		want    PhoneNumber
+		wantErr error
+	}{
+		{"CO international with spaces", "+57 300 123 4567", CountryColombia, PhoneNumber{E164: "+573001234567", Country: CountryColombia}, nil},
+		{"CO national", "3001234567", CountryColombia, PhoneNumber{E164: "+573001234567", Country: CountryColombia}, nil},
+		{"CO trunk zero and calling code", "0573001234567", CountryColombia, PhoneNumber{E164: "+573001234567", Country: CountryColombia}, nil},
+		{"CO calling code without plus", "573001234567", CountryColombia, PhoneNumber{E164: "+573001234567", Country: CountryColombia}, nil},
+		{"CO double zero prefix", "00573001234567", CountryColombia, PhoneNumber{E164: "+573001234567", Country: CountryColombia}, nil},
+		{"default country", "(300) 123-4567", "", PhoneNumber{E164: "+573001234567", Country: CountryColombia}, nil},
+		{"EC national with trunk zero", "0991234567", CountryEcuador, PhoneNumber{E164: "+593991234567", Country: CountryEcuador}, nil},
+		{"EC international", "+593 99 123 4567", CountryColombia, PhoneNumber{E164: "+593991234567", Country: CountryEcuador}, nil},
+		{"PE national", "912 345 678", CountryPeru, PhoneNumber{E164: "+51912345678", Country: CountryPeru}, nil},
+		{"PE international", "+51 912 345 678", CountryColombia, PhoneNumber{E164: "+51912345678", Country: CountryPeru}, nil},
+		{"CO landline", "6011234567", CountryColombia, PhoneNumber{}, ErrInvalidPhoneNumber},
+		{"letters", "300-ABC-4567", CountryColombia, PhoneNumber{}, ErrInvalidPhoneNumber},
+		{"unknown calling code", "+1 202 555 0100", CountryColombia, PhoneNumber{}, ErrInvalidPhoneNumber},
+		{"unsupported country", "3001234567", "BR", PhoneNumber{}, ErrUnsupportedCountry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizePhoneNumber(tt.raw, tt.country)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NormalizePhoneNumber(%q, %q) error = %v, want %v", tt.raw, tt.country, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizePhoneNumber(%q, %q) = %+v, want %+v", tt.raw, tt.country, got, tt.want)
+			}
+		})
+	}
+}
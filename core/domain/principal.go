@@ -0,0 +1,38 @@
+package domain
+
+import "slices"
+
+const (
+	PrincipalPerson = "person"
+	PrincipalAPIKey = "api_key"
+)
+
+// Principal is the authenticated caller of a request: a person signed in
+// with a bearer token or a service using an API key.
+type Principal struct {
+	Type      string
+	ID        string
+	Email     string
+	Role      string
+	Purpose   string
+	SessionID string
+	Scopes    []string
+}
+
+func (p Principal) IsPerson() bool {
+	return p.Type == PrincipalPerson
+}
+
+func (p Principal) IsAPIKey() bool {
+	return p.Type == PrincipalAPIKey
+}
+
+// HasScopes reports whether every given scope was granted.
+func (p Principal) HasScopes(scopes ...string) bool {
+	for _, scope := range scopes {
+		if !slices.Contains(p.Scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
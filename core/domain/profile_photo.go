@@ -0,0 +1,41 @@
+package domain
+
+import "strings"
+
+const (
+	PhotoContentTypeJPEG = "image/jpeg"
+	PhotoContentTypePNG  = "image/png"
+)
+
+func IsSupportedPhotoType(contentType string) bool {
+	return contentType == PhotoContentTypeJPEG || contentType == PhotoContentTypePNG
+}
+
+// ProfilePhotoKey is the storage key of a profile photo. Every upload gets a
+// new photoID, so cached copies of a replaced photo are never served again.
+func ProfilePhotoKey(personID, photoID string) string {
+	return "persons/" + personID + "/photos/" + photoID + ".jpg"
+}
+
+// ProfilePhotoThumbnailKey is the storage key of the thumbnail stored next
+// to the photo with the given key.
+func ProfilePhotoThumbnailKey(photoKey string) string {
+	return strings.TrimSuffix(photoKey, ".jpg") + "_thumb.jpg"
+}
+
+// ProfilePhoto is where a person's photo and its thumbnail are stored.
+type ProfilePhoto struct {
+	Key          string
+	URL          string
+	ThumbnailURL string
+}
+
+func (u *Person) HasPhoto() bool {
+	return u.PhotoKey != ""
+}
+
+func (u *Person) SetPhoto(photo ProfilePhoto) {
+	u.PhotoKey = photo.Key
+	u.PhotoURL = photo.URL
+	u.PhotoThumbnailURL = photo.ThumbnailURL
+}
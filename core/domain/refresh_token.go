@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+type RefreshToken struct {
+	ID        string
+	PersonID  string
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+}
+
+func (t RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+func (t RefreshToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+func (t RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "slices"
+
+const (
+	RolePassenger = "passenger"
+	RoleDriver    = "driver"
+	RoleAdmin     = "admin"
+	RoleSupport   = "support"
+
+	DefaultRole = RolePassenger
+)
+
+var (
+	roleCatalogue       = []string{RolePassenger, RoleDriver, RoleAdmin, RoleSupport}
+	selfAssignableRoles = []string{RolePassenger, RoleDriver}
+)
+
+// roleRanks orders the roles by privilege. Roles missing from it rank lowest.
+var roleRanks = map[string]int{RoleSupport: 1, RoleAdmin: 2}
+
+// CanManageRole reports whether a caller with the actor role may act on an
+// account with the target role. Admins manage every account; anybody else
+// only accounts that rank strictly below them, so support agents cannot
+// act on each other.
+func CanManageRole(actor, target string) bool {
+	return actor == RoleAdmin || roleRanks[target] < roleRanks[actor]
+}
+
+func IsValidRole(role string) bool {
+	return slices.Contains(roleCatalogue, role)
+}
+
+// IsSelfAssignableRole reports whether a person may pick the role themselves
+// at registration. Elevated roles are only granted by an admin.
+func IsSelfAssignableRole(role string) bool {
+	return slices.Contains(selfAssignableRoles, role)
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCanManageRole(t *testing.T) {
+	tests := []struct {
+		name   string
+		actor  string
+		target string
+		want   bool
+	}{
+		{"support on support", RoleSupport, RoleSupport, false},
+		{"support on admin", RoleSupport, RoleAdmin, false},
+		{"support on driver", RoleSupport, RoleDriver, true},
+		{"support on passenger", RoleSupport, RolePassenger, true},
+		{"admin on support", RoleAdmin, RoleSupport, true},
+		{"admin on admin", RoleAdmin, RoleAdmin, true},
+		{"driver on passenger", RoleDriver, RolePassenger, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanManageRole(tt.actor, tt.target); got != tt.want {
+				t.Errorf("CanManageRole(%q, %q) = %v, want %v", tt.actor, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanOverrideStatus(t *testing.T) {
+	now := time.Now()
+	expired := now.Add(-time.Hour)
+
+	tests := []struct {
+		name   string
+		person Person
+		actor  string
+		want   bool
+	}{
+		{"support lifts admin ban", Person{Status: AccountStatusBanned, StatusSetByRole: RoleAdmin}, RoleSupport, false},
+		{"support lifts support suspension", Person{Status: AccountStatusSuspended, StatusSetByRole: RoleSupport}, RoleSupport, true},
+		{"admin lifts support ban", Person{Status: AccountStatusBanned, StatusSetByRole: RoleSupport}, RoleAdmin, true},
+		{"support after admin suspension expired", Person{Status: AccountStatusSuspended, StatusExpiresAt: &expired, StatusSetByRole: RoleAdmin}, RoleSupport, true},
+		{"support on active account", Person{Status: AccountStatusActive, StatusSetByRole: RoleAdmin}, RoleSupport, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.person.CanOverrideStatus(tt.actor, now); got != tt.want {
+				t.Errorf("CanOverrideStatus(%q) = %v, want %v", tt.actor, got, tt.want)
+			}
+		})
+	}
+}
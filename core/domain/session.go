@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Session is one logged-in device. Its ID is shared with the refresh token
+// family issued at login and is carried in every access token.
+type Session struct {
+	ID         string
+	PersonID   string
+	DeviceName string
+	Platform   string
+	IP         string
+	UserAgent  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	RevokedAt  *time.Time
+}
+
+func (s Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
+
+// ClientInfo describes the device a request comes from.
+type ClientInfo struct {
+	IP         string
+	UserAgent  string
+	DeviceName string
+	Platform   string
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// SigningKey is an asymmetric key pair used to sign access tokens. A key is
+// published as soon as it is stored but only signs from ActivatesAt, once
+// verifiers had time to fetch it. Retired keys stop signing at RetiredAt and
+// keep verifying until VerifyUntil, when every token they signed has expired.
+type SigningKey struct {
+	ID          string
+	Algorithm   string
+	PrivateKey  string
+	PublicKey   string
+	CreatedAt   time.Time
+	ActivatesAt time.Time
+	RetiredAt   *time.Time
+	VerifyUntil *time.Time
+}
+
+// CanSignAt reports whether the key has been activated and not yet retired
+// at now.
+func (k SigningKey) CanSignAt(now time.Time) bool {
+	if now.Before(k.ActivatesAt) {
+		return false
+	}
+	return k.RetiredAt == nil || now.Before(*k.RetiredAt)
+}
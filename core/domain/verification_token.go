@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+const (
+	VerificationPurposeEmail         = "email_verification"
+	VerificationPurposePasswordReset = "password_reset"
+)
+
+type VerificationToken struct {
+	ID        string
+	PersonID  string
+	Purpose   string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
+
+func (t VerificationToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+func (t VerificationToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
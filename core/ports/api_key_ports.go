@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type APIKeyRepository interface {
+	Save(key domain.APIKey) error
+	GetByHash(keyHash string) (*domain.APIKey, error)
+	GetByID(id string) (*domain.APIKey, error)
+	List() ([]domain.APIKey, error)
+	Revoke(id string, at time.Time) error
+	TouchLastUsed(id string, at time.Time) error
+}
+
+type APIKeyService interface {
+	// CreateAPIKey returns the stored key and the raw key, which is only
+	// available at creation time.
+	CreateAPIKey(name string, scopes []string, expiresAt *time.Time, createdBy string) (domain.APIKey, string, error)
+	ListAPIKeys() ([]domain.APIKey, error)
+	RevokeAPIKey(id string) error
+	Authenticate(rawKey string) (*domain.Principal, error)
+}
@@ -0,0 +1,19 @@
+package ports
+
+import "github.com/EstebanGitPro/motogo-backend/core/domain"
+
+type AuthService interface {
+	// Login returns the tokens directly or, when the account uses or needs
+	// two-factor authentication, a short-lived MFA token instead.
+	Login(email, password string, client domain.ClientInfo) (domain.LoginResult, error)
+	// LoginExternal logs in a person already authenticated by an identity
+	// provider.
+	LoginExternal(personID string, client domain.ClientInfo) (domain.LoginResult, error)
+	CompleteMFALogin(mfaToken, code string, client domain.ClientInfo) (domain.AuthTokens, error)
+	// IssueTokensFor starts a session for a person who finished a
+	// mandatory 2FA enrollment.
+	IssueTokensFor(personID string, client domain.ClientInfo) (domain.AuthTokens, error)
+	Refresh(refreshToken, clientIP string) (domain.AuthTokens, error)
+	Logout(refreshToken string) error
+	ClearLoginLockout(email, ip string) error
+}
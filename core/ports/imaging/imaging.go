@@ -0,0 +1,16 @@
+package imaging
+
+type Image struct {
+	ContentType string
+	Data        []byte
+	Width       int
+	Height      int
+}
+
+// Processor re-encodes uploaded photos. The results carry no metadata from
+// the original file, so EXIF data such as GPS coordinates is dropped.
+type Processor interface {
+	// Process scales the photo down to fit within maxDimension and crops the
+	// center square of it into a thumbnail of thumbnailSize.
+	Process(data []byte, maxDimension, thumbnailSize int) (photo Image, thumbnail Image, err error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import "time"
+
+// LoginAttemptStore keeps failed login attempts and lockouts per key, where a
+// key identifies either an email or a client IP.
+type LoginAttemptStore interface {
+	RecordFailure(key string, at time.Time) error
+	// Failures returns the failure times recorded for key since the given
+	// instant, oldest first.
+	Failures(key string, since time.Time) ([]time.Time, error)
+	Lock(key string, until time.Time) error
+	// LockedUntil returns the end of the active lockout for key, or the zero
+	// time when key is not locked.
+	LockedUntil(key string) (time.Time, error)
+	Clear(key string) error
+}
@@ -0,0 +1,37 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type MFARepository interface {
+	// SaveFactor stores a pending factor, replacing any unconfirmed one.
+	SaveFactor(factor domain.MFAFactor) error
+	GetFactor(personID string) (*domain.MFAFactor, error)
+	ConfirmFactor(personID string, confirmedAt time.Time) error
+	// UpdateLastUsedStep reports false when step is not newer than the last
+	// accepted one, which means the code is being replayed.
+	UpdateLastUsedStep(personID string, step int64) (bool, error)
+	DeleteFactor(personID string) error
+	ReplaceRecoveryCodes(personID string, codes []domain.MFARecoveryCode) error
+	// UseRecoveryCode reports false when no unused code matches the hash.
+	UseRecoveryCode(personID, codeHash string, usedAt time.Time) (bool, error)
+	GetRolePolicies() ([]domain.MFARolePolicy, error)
+	SetRolePolicy(policy domain.MFARolePolicy) error
+}
+
+type MFAService interface {
+	BeginTOTPEnrollment(personID string) (domain.MFAEnrollment, error)
+	// ConfirmTOTPEnrollment activates the factor and returns the recovery
+	// codes, which are only ever shown this once.
+	ConfirmTOTPEnrollment(personID, code string) ([]string, error)
+	DisableTOTP(personID, code string) error
+	IsEnrolled(personID string) (bool, error)
+	IsRequiredForRole(role string) (bool, error)
+	// VerifyCode accepts either a TOTP code or an unused recovery code.
+	VerifyCode(personID, code string) error
+	ListRolePolicies() ([]domain.MFARolePolicy, error)
+	SetRolePolicy(role string, required bool) error
+}
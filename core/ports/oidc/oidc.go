@@ -0,0 +1,26 @@
+package oidc
+
+// AuthorizationRequest holds the per-login values sent to the provider. The
+// code challenge is the S256 hash of the verifier kept on our side (PKCE).
+type AuthorizationRequest struct {
+	State         string
+	Nonce         string
+	CodeChallenge string
+}
+
+// Identity is what we accept from a validated ID token.
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	GivenName     string
+	FamilyName    string
+}
+
+type Provider interface {
+	Name() string
+	AuthorizationURL(request AuthorizationRequest) (string, error)
+	// Exchange redeems the authorization code and validates the returned ID
+	// token, including its nonce.
+	Exchange(code, codeVerifier, nonce string) (Identity, error)
+}
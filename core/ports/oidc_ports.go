@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type ExternalIdentityRepository interface {
+	Save(identity domain.ExternalIdentity) error
+	Get(provider, subject string) (*domain.ExternalIdentity, error)
+	ListByPerson(personID string) ([]domain.ExternalIdentity, error)
+	SaveLoginState(state domain.OIDCLoginState) error
+	// ConsumeLoginState returns the state and deletes it, so a callback can
+	// only be completed once.
+	ConsumeLoginState(state string) (*domain.OIDCLoginState, error)
+	DeleteExpiredLoginStates(before time.Time) error
+}
+
+type OIDCService interface {
+	// BeginLogin returns the provider URL the client must open.
+	BeginLogin(provider string) (string, error)
+	// CompleteLogin handles the provider callback and logs the linked or newly
+	// created person in, including the second factor when required.
+	CompleteLogin(provider, code, state string, client domain.ClientInfo) (domain.LoginResult, error)
+}
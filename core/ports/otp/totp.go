@@ -0,0 +1,11 @@
+package otp
+
+import "time"
+
+type TOTP interface {
+	GenerateSecret() (string, error)
+	ProvisioningURI(accountName, secret string) string
+	// Validate checks code against secret around the given instant and returns
+	// the time step it matched, so callers can reject replays.
+	Validate(secret, code string, at time.Time) (step int64, ok bool)
+}
@@ -0,0 +1,8 @@
+package password
+
+type Hasher interface {
+	Hash(password string) (string, error)
+	// Verify reports whether password matches hash and whether hash was made
+	// with an older scheme or parameters and should be replaced.
+	Verify(hash, password string) (match bool, needsRehash bool, err error)
+}
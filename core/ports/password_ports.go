@@ -0,0 +1,9 @@
+package ports
+
+type PasswordService interface {
+	// RequestPasswordReset fails only when the email or the client IP asked
+	// too often; whether the account exists is never reported.
+	RequestPasswordReset(email, clientIP string) error
+	ResetPassword(token, newPassword string) error
+	ChangePassword(personID, currentPassword, newPassword string) error
+}
@@ -5,9 +5,43 @@ import "github.com/EstebanGitPro/motogo-backend/core/domain"
 type Repository interface {
 	Save(person domain.Person) error
 	GetPersonByEmail(email string) (*domain.Person, error)
+	GetPersonByID(id string) (*domain.Person, error)
+	SetEmailVerified(id string) error
+	SetPhoneNumberVerified(id string) error
+	// UpdateRole, UpdateStatus and UpdatePhoto fail with ErrVersionConflict
+	// when the stored row is no longer at version.
+	UpdateRole(id, role string, version int64) error
+	UpdatePassword(id, passwordHash string) error
+	UpdateStatus(id string, change domain.AccountStatusChange, version int64) error
+	UpdatePhoto(id string, photo domain.ProfilePhoto, version int64) error
+	// Update stores the profile fields and verification flags of the person
+	// if the stored row is still at person.Version.
+	Update(person domain.Person) error
+	ListPersons(query domain.PersonQuery) ([]domain.Person, error)
+	ListEmailAccounts() ([]domain.EmailAccount, error)
 }
 
 type Service interface {
 	RegisterPerson(person domain.Person) (domain.Person, error)
 	GetPersonByEmail(email string) (*domain.Person, error)
+	// AssignRole changes the role if the person is still at version and
+	// fails with ErrVersionConflict otherwise.
+	AssignRole(id, role string, version int64) (*domain.Person, error)
+	GetPersonByID(id string) (*domain.Person, error)
+	// UpdatePerson applies the update if the person is still at version and
+	// fails with ErrVersionConflict otherwise.
+	UpdatePerson(id string, update domain.PersonUpdate, version int64) (*domain.Person, error)
+	// SetAccountStatus applies an admin decision such as a suspension. It
+	// fails with ErrRoleOutranksCaller when actorRole may not manage the
+	// account, with ErrStatusSetByHigher when a higher role set the current
+	// status and with ErrAccountDeletionPending while the account waits to
+	// be deleted. Like UpdatePerson it only applies to the given version.
+	SetAccountStatus(actorRole, id string, change domain.AccountStatusChange, version int64) (*domain.Person, error)
+	// CheckAccountStatus fails with ErrAccountSuspended or ErrAccountBanned
+	// when the person may not use the API. Otherwise it returns the person as
+	// stored, whose role replaces the one in the token.
+	CheckAccountStatus(id string) (*domain.Person, error)
+	// ListPersons returns one page of persons. The cursor is the opaque
+	// NextCursor of the previous page and only valid with the same sort.
+	ListPersons(filter domain.PersonFilter, sortKey, cursor string, limit int) (domain.PersonPage, error)
 }
\ No newline at end of file
@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type PhoneOTPRepository interface {
+	Save(otp domain.PhoneOTP) error
+	GetLatestByPerson(personID string) (*domain.PhoneOTP, error)
+	// IncrementAttempts counts one more attempt unless maxAttempts were
+	// already made, in which case it reports false. The check and the
+	// increment are one statement, so parallel requests cannot exceed it.
+	IncrementAttempts(id string, maxAttempts int) (bool, error)
+	// MarkConsumed reports false when the code had already been consumed.
+	MarkConsumed(id string, consumedAt time.Time) (bool, error)
+}
+
+type PhoneVerificationService interface {
+	RequestPhoneOTP(personID string) error
+	ConfirmPhoneOTP(personID, code string) error
+	EnsurePhoneVerified(personID string) error
+}
@@ -0,0 +1,11 @@
+package ports
+
+import "github.com/EstebanGitPro/motogo-backend/core/domain"
+
+type PhotoService interface {
+	// UploadProfilePhoto checks and re-encodes the uploaded image, stores it
+	// with a thumbnail and replaces the person's previous photo. Both calls
+	// fail with ErrVersionConflict when the person is no longer at version.
+	UploadProfilePhoto(personID string, data []byte, version int64) (*domain.Person, error)
+	DeleteProfilePhoto(personID string, version int64) (*domain.Person, error)
+}
@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type AccountDeletionRepository interface {
+	// Save stores a pending request, replacing a cancelled or completed one.
+	Save(deletion domain.AccountDeletion) error
+	Get(personID string) (*domain.AccountDeletion, error)
+	// Cancel removes a pending request. It reports false when there was none.
+	Cancel(personID string) (bool, error)
+	// ListDue returns up to limit pending requests scheduled at or before at.
+	ListDue(at time.Time, limit int) ([]domain.AccountDeletion, error)
+	// Anonymize replaces the person's personal data, soft-deletes the person,
+	// deletes the credentials, sessions and linked identities that still
+	// identify them and marks the request completed, all in one transaction.
+	Anonymize(person domain.Person, at time.Time) error
+}
+
+type PrivacyService interface {
+	ExportPersonData(personID string) (domain.PersonDataExport, error)
+	RequestAccountDeletion(personID string) (domain.AccountDeletion, error)
+	GetAccountDeletion(personID string) (*domain.AccountDeletion, error)
+	CancelAccountDeletion(personID string) error
+	// PurgeDueAccounts anonymizes the accounts whose grace period has ended
+	// and returns how many were anonymized.
+	PurgeDueAccounts(now time.Time) (int, error)
+}
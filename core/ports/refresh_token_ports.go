@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type RefreshTokenRepository interface {
+	Save(token domain.RefreshToken) error
+	GetByHash(tokenHash string) (*domain.RefreshToken, error)
+	// MarkUsed flags the token as rotated. It reports false when the token was
+	// already used or revoked, which callers must treat as reuse.
+	MarkUsed(id string, usedAt time.Time) (bool, error)
+	RevokeFamily(familyID string) error
+	RevokeAllForPerson(personID string) error
+}
@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type SessionRepository interface {
+	Save(session domain.Session) error
+	GetByID(id string) (*domain.Session, error)
+	// ListActiveByPerson returns the sessions that were not revoked, most
+	// recently seen first.
+	ListActiveByPerson(personID string) ([]domain.Session, error)
+	// ListByPerson returns every session of the person, revoked or not.
+	ListByPerson(personID string) ([]domain.Session, error)
+	Touch(id, ip string, at time.Time) error
+	Revoke(id string, at time.Time) error
+	RevokeAllForPerson(personID string, at time.Time) error
+}
+
+type SessionService interface {
+	ListSessions(personID string) ([]domain.Session, error)
+	// RevokeSession ends one of the person's own sessions and its refresh
+	// tokens.
+	RevokeSession(personID, sessionID string) error
+	RevokeAllSessions(personID string) error
+	// CheckSession fails with ErrSessionRevoked when the session behind an
+	// access token has been ended, and records the activity otherwise.
+	CheckSession(personID, sessionID, ip string) error
+}
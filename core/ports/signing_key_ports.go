@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
+)
+
+type SigningKeyRepository interface {
+	Save(key domain.SigningKey) error
+	// ListVerifiable returns the keys that still verify tokens at the given
+	// instant, newest first.
+	ListVerifiable(at time.Time) ([]domain.SigningKey, error)
+	// RetireActive makes every unretired key except exceptID stop signing at
+	// the given instant while keeping it valid for verification until
+	// verifyUntil.
+	RetireActive(exceptID string, at, verifyUntil time.Time) error
+}
+
+type SigningKeyService interface {
+	// RotateSigningKey creates a new signing key and returns its key ID.
+	RotateSigningKey() (string, error)
+	// EnsureSigningKey creates the first key when none is available yet.
+	EnsureSigningKey() error
+	PublicKeys() ([]token.JSONWebKey, error)
+}
@@ -0,0 +1,5 @@
+package sms
+
+type Sender interface {
+	Send(phoneNumber, message string) error
+}
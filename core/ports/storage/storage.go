@@ -0,0 +1,15 @@
+package storage
+
+type Object struct {
+	Key         string
+	ContentType string
+	Data        []byte
+}
+
+// ObjectStorage keeps uploaded files. Keys are slash separated paths chosen
+// by the services; Put returns the public URL of the stored object.
+type ObjectStorage interface {
+	Put(object Object) (url string, err error)
+	// Delete removes the object. Deleting a missing key is not an error.
+	Delete(key string) error
+}
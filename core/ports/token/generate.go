@@ -2,12 +2,23 @@ package token
 
 import "time"
 
+// Purposes a token can be issued for. Only access tokens open protected
+// routes; the MFA ones are limited to finishing a two-step login.
+const (
+	PurposeAccess        = "access"
+	PurposeMFAPending    = "mfa_pending"
+	PurposeMFAEnrollment = "mfa_enrollment"
+)
+
 type Claims struct {
-	ID    string `json:"id"`
-	Email string `json:"email"`
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	Purpose   string `json:"purpose"`
+	SessionID string `json:"session_id,omitempty"`
 }
 
 type Generator interface {
-	Generate(userID string, duration time.Duration) (string, error)
+	Generate(claims Claims, duration time.Duration) (string, error)
 	Validate(tokenString string) (*Claims, error)
 }
@@ -0,0 +1,53 @@
+package token
+
+import "time"
+
+// Signing algorithms supported for access tokens. HS256 uses the shared
+// secret; the asymmetric ones sign with rotating key pairs.
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+const (
+	// KeyReloadInterval bounds how long an instance keeps signing with a key
+	// that another instance has already rotated out.
+	KeyReloadInterval = 5 * time.Minute
+	// KeySetMaxAge is how long clients may cache the published key set.
+	KeySetMaxAge = 5 * time.Minute
+)
+
+// KeyPair is a freshly generated signing key in PEM form.
+type KeyPair struct {
+	ID         string
+	Algorithm  string
+	PrivateKey string
+	PublicKey  string
+}
+
+type KeyFactory interface {
+	NewKeyPair(algorithm string) (KeyPair, error)
+}
+
+// JSONWebKey is the public part of a verification key as published in the
+// JWKS document (RFC 7517).
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Modulus   string `json:"n,omitempty"`
+	Exponent  string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+// KeySet exposes the keys that currently verify tokens.
+type KeySet interface {
+	PublicKeys() ([]JSONWebKey, error)
+	// HasSigningKey reports whether a key is available to sign new tokens.
+	HasSigningKey() bool
+	// Reload picks up keys rotated by this or another instance.
+	Reload() error
+}
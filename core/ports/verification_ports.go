@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type VerificationTokenRepository interface {
+	Save(token domain.VerificationToken) error
+	GetByHash(tokenHash string) (*domain.VerificationToken, error)
+	// MarkUsed consumes the token. It reports false when the token had
+	// already been used.
+	MarkUsed(id string, usedAt time.Time) (bool, error)
+	InvalidateForPerson(personID, purpose string) error
+}
+
+type VerificationService interface {
+	SendEmailVerification(person domain.Person) error
+	VerifyEmail(token string) error
+	ResendEmailVerification(email string) error
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/google/uuid"
+)
+
+const (
+	apiKeyPrefix       = "mgk_"
+	apiKeyPrefixLength = len(apiKeyPrefix) + 8
+	// apiKeyTouchInterval limits how often a busy key writes its last-used
+	// time.
+	apiKeyTouchInterval = time.Minute
+)
+
+type apiKeyService struct {
+	keys ports.APIKeyRepository
+}
+
+func NewAPIKeyService(keys ports.APIKeyRepository) ports.APIKeyService {
+	return &apiKeyService{
+		keys: keys,
+	}
+}
+
+func (s apiKeyService) CreateAPIKey(name string, scopes []string, expiresAt *time.Time, createdBy string) (domain.APIKey, string, error) {
+	for _, scope := range scopes {
+		if !domain.IsValidScope(scope) {
+			return domain.APIKey{}, "", domain.ErrInvalidScope
+		}
+	}
+
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return domain.APIKey{}, "", domain.ErrAPIKeyExpiryInPast
+	}
+
+	secret, _, err := newOpaqueToken()
+	if err != nil {
+		return domain.APIKey{}, "", domain.ErrAPIKeyCannotSave
+	}
+	rawKey := apiKeyPrefix + secret
+
+	key := domain.APIKey{
+		ID:        uuid.New().String(),
+		Name:      name,
+		Prefix:    rawKey[:apiKeyPrefixLength],
+		KeyHash:   hashOpaqueToken(rawKey),
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(scopes))),
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		ExpiresAt: expiresAt,
+	}
+	if err := s.keys.Save(key); err != nil {
+		return domain.APIKey{}, "", err
+	}
+
+	slog.Info("Created api key",
+		slog.String("api_key_id", key.ID),
+		slog.String("created_by", createdBy))
+
+	return key, rawKey, nil
+}
+
+func (s apiKeyService) ListAPIKeys() ([]domain.APIKey, error) {
+	return s.keys.List()
+}
+
+func (s apiKeyService) RevokeAPIKey(id string) error {
+	key, err := s.keys.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	if key.IsRevoked() {
+		return nil
+	}
+
+	return s.keys.Revoke(id, time.Now())
+}
+
+func (s apiKeyService) Authenticate(rawKey string) (*domain.Principal, error) {
+	key, err := s.keys.GetByHash(hashOpaqueToken(rawKey))
+	if err != nil {
+		if errors.Is(err, domain.ErrAPIKeyNotFound) {
+			return nil, domain.ErrInvalidAPIKey
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	if key.IsRevoked() || key.IsExpired(now) {
+		return nil, domain.ErrInvalidAPIKey
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyTouchInterval {
+		if err := s.keys.TouchLastUsed(key.ID, now); err != nil {
+			slog.Warn("Error updating api key last use",
+				slog.String("api_key_id", key.ID),
+				slog.String("error", err.Error()))
+		}
+	}
+
+	principal := key.Principal()
+	return &principal, nil
+}
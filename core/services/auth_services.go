@@ -0,0 +1,375 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/password"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
+	"github.com/google/uuid"
+)
+
+const tokenTypeBearer = "Bearer"
+
+type authService struct {
+	repository    ports.Repository
+	refreshTokens ports.RefreshTokenRepository
+	sessions      ports.SessionRepository
+	tokens        token.Generator
+	hasher        password.Hasher
+	mfa           ports.MFAService
+	guard         loginGuard
+	// dummyPerson is checked when the email is unknown so that a failed
+	// login takes the same time whether or not the account exists.
+	dummyPerson domain.Person
+	config      *config.Config
+}
+
+func NewAuthService(repo ports.Repository, refreshTokens ports.RefreshTokenRepository, sessions ports.SessionRepository, generator token.Generator, hasher password.Hasher, mfa ports.MFAService, attempts ports.LoginAttemptStore, cfg *config.Config) ports.AuthService {
+	dummyPerson := domain.Person{Password: uuid.New().String()}
+	if err := dummyPerson.HashPassword(hasher); err != nil {
+		slog.Warn("Error hashing dummy password", slog.String("error", err.Error()))
+	}
+
+	return &authService{
+		repository:    repo,
+		refreshTokens: refreshTokens,
+		sessions:      sessions,
+		tokens:        generator,
+		hasher:        hasher,
+		mfa:           mfa,
+		guard:         newLoginGuard(attempts, cfg.LoginProtection),
+		dummyPerson:   dummyPerson,
+		config:        cfg,
+	}
+}
+
+func (s authService) Login(email, plainPassword string, client domain.ClientInfo) (domain.LoginResult, error) {
+	emailKey := domain.LoginAttemptEmailKey(email)
+	ipKey := domain.LoginAttemptIPKey(client.IP)
+
+	if err := s.guard.check(emailKey, ipKey); err != nil {
+		return domain.LoginResult{}, err
+	}
+
+	person, err := s.repository.GetPersonByEmail(email)
+	if err != nil {
+		if errors.Is(err, domain.ErrPersonNotFound) {
+			s.dummyPerson.CheckPassword(s.hasher, plainPassword)
+			return domain.LoginResult{}, s.failLogin(emailKey, ipKey)
+		}
+		return domain.LoginResult{}, domain.ErrUserCannotGet
+	}
+
+	match, needsRehash := person.CheckPassword(s.hasher, plainPassword)
+	if !match {
+		return domain.LoginResult{}, s.failLogin(emailKey, ipKey)
+	}
+
+	if err := person.CheckAccountStatus(time.Now()); err != nil {
+		return domain.LoginResult{}, err
+	}
+
+	if !person.EmailVerified {
+		return domain.LoginResult{}, domain.ErrorEmailNotVerified
+	}
+
+	if needsRehash {
+		s.rehashPassword(*person, plainPassword)
+	}
+
+	if err := s.guard.recordSuccess(emailKey); err != nil {
+		slog.Warn("Error clearing login failures", slog.String("error", err.Error()))
+	}
+
+	return s.secondFactorOrTokens(*person, client)
+}
+
+func (s authService) LoginExternal(personID string, client domain.ClientInfo) (domain.LoginResult, error) {
+	person, err := s.repository.GetPersonByID(personID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPersonNotFound) {
+			return domain.LoginResult{}, err
+		}
+		return domain.LoginResult{}, domain.ErrUserCannotGet
+	}
+
+	if err := person.CheckAccountStatus(time.Now()); err != nil {
+		return domain.LoginResult{}, err
+	}
+
+	return s.secondFactorOrTokens(*person, client)
+}
+
+func (s authService) CompleteMFALogin(mfaToken, code string, client domain.ClientInfo) (domain.AuthTokens, error) {
+	claims, err := s.tokens.Validate(mfaToken)
+	if err != nil || claims.Purpose != token.PurposeMFAPending {
+		return domain.AuthTokens{}, domain.ErrInvalidToken
+	}
+
+	mfaKey := domain.LoginAttemptMFAKey(claims.ID)
+	ipKey := domain.LoginAttemptIPKey(client.IP)
+
+	if err := s.guard.check(mfaKey, ipKey); err != nil {
+		return domain.AuthTokens{}, err
+	}
+
+	if err := s.mfa.VerifyCode(claims.ID, code); err != nil {
+		if errors.Is(err, domain.ErrInvalidMFACode) {
+			if err := s.guard.recordFailure(mfaKey, ipKey); err != nil {
+				slog.Error("Error recording mfa failure", slog.String("error", err.Error()))
+			}
+		}
+		return domain.AuthTokens{}, err
+	}
+
+	if err := s.guard.recordSuccess(mfaKey); err != nil {
+		slog.Warn("Error clearing mfa failures", slog.String("error", err.Error()))
+	}
+
+	return s.IssueTokensFor(claims.ID, client)
+}
+
+func (s authService) IssueTokensFor(personID string, client domain.ClientInfo) (domain.AuthTokens, error) {
+	person, err := s.repository.GetPersonByID(personID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPersonNotFound) {
+			return domain.AuthTokens{}, domain.ErrInvalidToken
+		}
+		return domain.AuthTokens{}, domain.ErrUserCannotGet
+	}
+
+	if err := person.CheckAccountStatus(time.Now()); err != nil {
+		return domain.AuthTokens{}, err
+	}
+
+	return s.startSession(*person, client)
+}
+
+func (s authService) Refresh(refreshToken, clientIP string) (domain.AuthTokens, error) {
+	stored, err := s.refreshTokens.GetByHash(hashOpaqueToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenNotFound) {
+			return domain.AuthTokens{}, domain.ErrInvalidToken
+		}
+		return domain.AuthTokens{}, err
+	}
+
+	if stored.IsRevoked() {
+		return domain.AuthTokens{}, domain.ErrInvalidToken
+	}
+
+	if stored.IsUsed() {
+		return domain.AuthTokens{}, s.revokeReusedFamily(*stored)
+	}
+
+	now := time.Now()
+	if stored.IsExpired(now) {
+		return domain.AuthTokens{}, domain.ErrInvalidToken
+	}
+
+	rotated, err := s.refreshTokens.MarkUsed(stored.ID, now)
+	if err != nil {
+		return domain.AuthTokens{}, err
+	}
+	if !rotated {
+		return domain.AuthTokens{}, s.revokeReusedFamily(*stored)
+	}
+
+	person, err := s.repository.GetPersonByID(stored.PersonID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPersonNotFound) {
+			return domain.AuthTokens{}, domain.ErrInvalidToken
+		}
+		return domain.AuthTokens{}, domain.ErrUserCannotGet
+	}
+
+	if err := person.CheckAccountStatus(now); err != nil {
+		return domain.AuthTokens{}, err
+	}
+
+	if err := s.sessions.Touch(stored.FamilyID, clientIP, now); err != nil {
+		slog.Warn("Error updating session last seen", slog.String("session_id", stored.FamilyID), slog.String("error", err.Error()))
+	}
+
+	return s.issueTokens(*person, stored.FamilyID)
+}
+
+func (s authService) Logout(refreshToken string) error {
+	stored, err := s.refreshTokens.GetByHash(hashOpaqueToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrRefreshTokenNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	return revokeSession(s.sessions, s.refreshTokens, stored.FamilyID)
+}
+
+func (s authService) ClearLoginLockout(email, ip string) error {
+	if email == "" && ip == "" {
+		return domain.ErrLockoutTargetRequired
+	}
+
+	if email != "" {
+		if err := s.guard.clear(domain.LoginAttemptEmailKey(email)); err != nil {
+			return err
+		}
+	}
+
+	if ip != "" {
+		if err := s.guard.clear(domain.LoginAttemptIPKey(ip)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// secondFactorOrTokens finishes a password login. Enrolled accounts get an
+// MFA pending token; accounts whose role requires 2FA but have not enrolled
+// get a token that only allows enrollment.
+func (s authService) secondFactorOrTokens(person domain.Person, client domain.ClientInfo) (domain.LoginResult, error) {
+	enrolled, err := s.mfa.IsEnrolled(person.ID)
+	if err != nil {
+		return domain.LoginResult{}, err
+	}
+	if enrolled {
+		return s.mfaChallenge(person, token.PurposeMFAPending)
+	}
+
+	required, err := s.mfa.IsRequiredForRole(person.Role)
+	if err != nil {
+		return domain.LoginResult{}, err
+	}
+	if required {
+		return s.mfaChallenge(person, token.PurposeMFAEnrollment)
+	}
+
+	tokens, err := s.startSession(person, client)
+	if err != nil {
+		return domain.LoginResult{}, err
+	}
+	return domain.LoginResult{Tokens: &tokens}, nil
+}
+
+func (s authService) mfaChallenge(person domain.Person, purpose string) (domain.LoginResult, error) {
+	ttl := s.config.MFA.PendingTokenTTL()
+
+	mfaToken, err := s.tokens.Generate(token.Claims{
+		ID:      person.ID,
+		Email:   person.Email,
+		Role:    person.Role,
+		Purpose: purpose,
+	}, ttl)
+	if err != nil {
+		return domain.LoginResult{}, domain.ErrTokenCannotCreate
+	}
+
+	return domain.LoginResult{
+		MFARequired:           purpose == token.PurposeMFAPending,
+		MFAEnrollmentRequired: purpose == token.PurposeMFAEnrollment,
+		MFAToken:              mfaToken,
+		MFAExpiresIn:          int64(ttl.Seconds()),
+	}, nil
+}
+
+func (s authService) failLogin(emailKey, ipKey string) error {
+	if err := s.guard.recordFailure(emailKey, ipKey); err != nil {
+		slog.Error("Error recording login failure", slog.String("error", err.Error()))
+	}
+	return domain.ErrInvalidCredentials
+}
+
+// rehashPassword upgrades a legacy hash after a successful login. A failure
+// only means the upgrade is retried on the next login.
+func (s authService) rehashPassword(person domain.Person, plainPassword string) {
+	person.Password = plainPassword
+	if err := person.HashPassword(s.hasher); err != nil {
+		slog.Warn("Error rehashing password", slog.String("person_id", person.ID), slog.String("error", err.Error()))
+		return
+	}
+
+	if err := s.repository.UpdatePassword(person.ID, person.Password); err != nil {
+		slog.Warn("Error storing rehashed password", slog.String("person_id", person.ID), slog.String("error", err.Error()))
+	}
+}
+
+func (s authService) revokeReusedFamily(stored domain.RefreshToken) error {
+	slog.Warn("Refresh token reuse detected, revoking token family",
+		slog.String("person_id", stored.PersonID),
+		slog.String("family_id", stored.FamilyID))
+
+	if err := revokeSession(s.sessions, s.refreshTokens, stored.FamilyID); err != nil {
+		return err
+	}
+	return domain.ErrRefreshTokenReused
+}
+
+// startSession records a new device session and issues its first tokens. The
+// session ID doubles as the refresh token family ID.
+func (s authService) startSession(person domain.Person, client domain.ClientInfo) (domain.AuthTokens, error) {
+	now := time.Now()
+	session := domain.Session{
+		ID:         uuid.New().String(),
+		PersonID:   person.ID,
+		DeviceName: client.DeviceName,
+		Platform:   client.Platform,
+		IP:         client.IP,
+		UserAgent:  client.UserAgent,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+	if err := s.sessions.Save(session); err != nil {
+		return domain.AuthTokens{}, err
+	}
+
+	return s.issueTokens(person, session.ID)
+}
+
+func (s authService) issueTokens(person domain.Person, familyID string) (domain.AuthTokens, error) {
+	accessTTL := s.config.JWT.AccessTokenTTL()
+	refreshTTL := s.config.JWT.RefreshTokenTTL()
+
+	accessToken, err := s.tokens.Generate(token.Claims{
+		ID:        person.ID,
+		Email:     person.Email,
+		Role:      person.Role,
+		Purpose:   token.PurposeAccess,
+		SessionID: familyID,
+	}, accessTTL)
+	if err != nil {
+		return domain.AuthTokens{}, domain.ErrTokenCannotCreate
+	}
+
+	refreshToken, refreshHash, err := newOpaqueToken()
+	if err != nil {
+		return domain.AuthTokens{}, domain.ErrTokenCannotCreate
+	}
+
+	now := time.Now()
+	err = s.refreshTokens.Save(domain.RefreshToken{
+		ID:        uuid.New().String(),
+		PersonID:  person.ID,
+		FamilyID:  familyID,
+		TokenHash: refreshHash,
+		ExpiresAt: now.Add(refreshTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return domain.AuthTokens{}, err
+	}
+
+	return domain.AuthTokens{
+		AccessToken:      accessToken,
+		TokenType:        tokenTypeBearer,
+		ExpiresIn:        int64(accessTTL.Seconds()),
+		RefreshToken:     refreshToken,
+		RefreshExpiresIn: int64(refreshTTL.Seconds()),
+	}, nil
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"fmt"
+	"html"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/mailer"
+)
+
+func emailVerificationMessage(person domain.Person, link string) mailer.Message {
+	return mailer.Message{
+		To:      person.Email,
+		Subject: "Verifica tu correo en MotoGo",
+		HTML: fmt.Sprintf(`<p>Hola %s,</p><p>Confirma tu correo haciendo clic en el siguiente enlace:</p><p><a href="%s">Verificar correo</a></p><p>Si no creaste una cuenta en MotoGo puedes ignorar este mensaje.</p>`,
+			html.EscapeString(person.FirstName), html.EscapeString(link)),
+		Text: fmt.Sprintf("Hola %s,\n\nConfirma tu correo abriendo el siguiente enlace:\n%s\n\nSi no creaste una cuenta en MotoGo puedes ignorar este mensaje.",
+			person.FirstName, link),
+	}
+}
+
+func passwordResetMessage(person domain.Person, link string) mailer.Message {
+	return mailer.Message{
+		To:      person.Email,
+		Subject: "Restablece tu contraseña de MotoGo",
+		HTML: fmt.Sprintf(`<p>Hola %s,</p><p>Recibimos una solicitud para restablecer tu contraseña. Usa el siguiente enlace para elegir una nueva:</p><p><a href="%s">Restablecer contraseña</a></p><p>Si no solicitaste el cambio puedes ignorar este mensaje; tu contraseña actual seguirá funcionando.</p>`,
+			html.EscapeString(person.FirstName), html.EscapeString(link)),
+		Text: fmt.Sprintf("Hola %s,\n\nRecibimos una solicitud para restablecer tu contraseña. Usa el siguiente enlace para elegir una nueva:\n%s\n\nSi no solicitaste el cambio puedes ignorar este mensaje; tu contraseña actual seguirá funcionando.",
+			person.FirstName, link),
+	}
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+)
+
+// loginGuard applies progressive delays and temporary lockouts to logins,
+// counting failures per email and per client IP in a sliding window.
+type loginGuard struct {
+	store    ports.LoginAttemptStore
+	settings config.LoginProtection
+}
+
+func newLoginGuard(store ports.LoginAttemptStore, settings config.LoginProtection) loginGuard {
+	return loginGuard{
+		store:    store,
+		settings: settings.WithDefaults(),
+	}
+}
+
+func (g loginGuard) check(emailKey, ipKey string) error {
+	now := time.Now()
+
+	for _, key := range []string{emailKey, ipKey} {
+		lockedUntil, err := g.store.LockedUntil(key)
+		if err != nil {
+			return err
+		}
+		if now.Before(lockedUntil) {
+			lockErr := domain.ErrAccountLocked
+			if key == ipKey {
+				lockErr = domain.ErrTooManyLoginAttempts
+			}
+			return &domain.RetryAfterError{Err: lockErr, RetryAfter: lockedUntil.Sub(now)}
+		}
+
+		failures, err := g.store.Failures(key, now.Add(-g.settings.Window()))
+		if err != nil {
+			return err
+		}
+		if wait := g.requiredDelay(failures, now); wait > 0 {
+			return &domain.RetryAfterError{Err: domain.ErrTooManyLoginAttempts, RetryAfter: wait}
+		}
+	}
+
+	return nil
+}
+
+func (g loginGuard) recordFailure(emailKey, ipKey string) error {
+	now := time.Now()
+
+	limits := map[string]int{
+		emailKey: g.settings.MaxFailuresPerEmail,
+		ipKey:    g.settings.MaxFailuresPerIP,
+	}
+
+	for key, limit := range limits {
+		if err := g.store.RecordFailure(key, now); err != nil {
+			return err
+		}
+
+		failures, err := g.store.Failures(key, now.Add(-g.settings.Window()))
+		if err != nil {
+			return err
+		}
+		if len(failures) >= limit {
+			if err := g.store.Lock(key, now.Add(g.settings.Lockout())); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g loginGuard) recordSuccess(emailKey string) error {
+	return g.store.Clear(emailKey)
+}
+
+func (g loginGuard) clear(key string) error {
+	return g.store.Clear(key)
+}
+
+// requiredDelay doubles the wait after every failure past the configured
+// threshold, measured from the most recent failure.
+func (g loginGuard) requiredDelay(failures []time.Time, now time.Time) time.Duration {
+	excess := len(failures) - g.settings.DelayAfterFailures
+	if excess < 0 {
+		return 0
+	}
+
+	delay := g.settings.BaseDelay()
+	for i := 0; i < excess && delay < g.settings.MaxDelay(); i++ {
+		delay *= 2
+	}
+	if delay > g.settings.MaxDelay() {
+		delay = g.settings.MaxDelay()
+	}
+
+	return failures[len(failures)-1].Add(delay).Sub(now)
+}
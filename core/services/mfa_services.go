@@ -0,0 +1,298 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/otp"
+	"github.com/google/uuid"
+)
+
+const (
+	recoveryCodeLength   = 10
+	recoveryCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+type mfaService struct {
+	repository ports.Repository
+	factors    ports.MFARepository
+	totp       otp.TOTP
+	config     *config.Config
+}
+
+func NewMFAService(repo ports.Repository, factors ports.MFARepository, totp otp.TOTP, cfg *config.Config) ports.MFAService {
+	return &mfaService{
+		repository: repo,
+		factors:    factors,
+		totp:       totp,
+		config:     cfg,
+	}
+}
+
+func (s mfaService) BeginTOTPEnrollment(personID string) (domain.MFAEnrollment, error) {
+	person, err := s.getPerson(personID)
+	if err != nil {
+		return domain.MFAEnrollment{}, err
+	}
+
+	if !domain.SupportsMFA(person.Role) {
+		return domain.MFAEnrollment{}, domain.ErrMFANotAvailableForRole
+	}
+
+	existing, err := s.factors.GetFactor(personID)
+	if err != nil && !errors.Is(err, domain.ErrMFAFactorNotFound) {
+		return domain.MFAEnrollment{}, err
+	}
+	if existing != nil && existing.IsConfirmed() {
+		return domain.MFAEnrollment{}, domain.ErrMFAAlreadyEnrolled
+	}
+
+	secret, err := s.totp.GenerateSecret()
+	if err != nil {
+		return domain.MFAEnrollment{}, domain.ErrMFACannotSave
+	}
+
+	err = s.factors.SaveFactor(domain.MFAFactor{
+		PersonID:  personID,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return domain.MFAEnrollment{}, err
+	}
+
+	return domain.MFAEnrollment{
+		Secret:          secret,
+		ProvisioningURI: s.totp.ProvisioningURI(person.Email, secret),
+	}, nil
+}
+
+func (s mfaService) ConfirmTOTPEnrollment(personID, code string) ([]string, error) {
+	factor, err := s.factors.GetFactor(personID)
+	if err != nil {
+		return nil, err
+	}
+
+	if factor.IsConfirmed() {
+		return nil, domain.ErrMFAAlreadyEnrolled
+	}
+
+	if err := s.verifyTOTP(*factor, code); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.factors.ConfirmFactor(personID, now); err != nil {
+		return nil, err
+	}
+
+	return s.issueRecoveryCodes(personID, now)
+}
+
+func (s mfaService) DisableTOTP(personID, code string) error {
+	person, err := s.getPerson(personID)
+	if err != nil {
+		return err
+	}
+
+	required, err := s.IsRequiredForRole(person.Role)
+	if err != nil {
+		return err
+	}
+	if required {
+		return domain.ErrMFARequiredByPolicy
+	}
+
+	if err := s.VerifyCode(personID, code); err != nil {
+		return err
+	}
+
+	return s.factors.DeleteFactor(personID)
+}
+
+func (s mfaService) IsEnrolled(personID string) (bool, error) {
+	factor, err := s.factors.GetFactor(personID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMFAFactorNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return factor.IsConfirmed(), nil
+}
+
+func (s mfaService) IsRequiredForRole(role string) (bool, error) {
+	if !domain.SupportsMFA(role) {
+		return false, nil
+	}
+
+	policies, err := s.factors.GetRolePolicies()
+	if err != nil {
+		return false, err
+	}
+
+	for _, policy := range policies {
+		if policy.Role == role {
+			return policy.Required, nil
+		}
+	}
+	return false, nil
+}
+
+func (s mfaService) VerifyCode(personID, code string) error {
+	factor, err := s.factors.GetFactor(personID)
+	if err != nil {
+		return err
+	}
+
+	if !factor.IsConfirmed() {
+		return domain.ErrMFAFactorNotFound
+	}
+
+	code = strings.TrimSpace(code)
+	if isNumeric(code) {
+		return s.verifyTOTP(*factor, code)
+	}
+
+	used, err := s.factors.UseRecoveryCode(personID, hashRecoveryCode(personID, code), time.Now())
+	if err != nil {
+		return err
+	}
+	if !used {
+		return domain.ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+// ListRolePolicies reports every role that can use 2FA, including the ones
+// that were never configured and are therefore optional.
+func (s mfaService) ListRolePolicies() ([]domain.MFARolePolicy, error) {
+	stored, err := s.factors.GetRolePolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	required := make(map[string]bool, len(stored))
+	for _, policy := range stored {
+		required[policy.Role] = policy.Required
+	}
+
+	var policies []domain.MFARolePolicy
+	for _, role := range domain.MFARoles {
+		policies = append(policies, domain.MFARolePolicy{Role: role, Required: required[role]})
+	}
+	return policies, nil
+}
+
+func (s mfaService) SetRolePolicy(role string, required bool) error {
+	if !domain.IsValidRole(role) {
+		return domain.ErrInvalidRole
+	}
+
+	if !domain.SupportsMFA(role) {
+		return domain.ErrMFANotAvailableForRole
+	}
+
+	return s.factors.SetRolePolicy(domain.MFARolePolicy{Role: role, Required: required})
+}
+
+// verifyTOTP accepts a code only once: the matched time step must be newer
+// than the last one used for this factor.
+func (s mfaService) verifyTOTP(factor domain.MFAFactor, code string) error {
+	step, ok := s.totp.Validate(factor.Secret, code, time.Now())
+	if !ok {
+		return domain.ErrInvalidMFACode
+	}
+
+	accepted, err := s.factors.UpdateLastUsedStep(factor.PersonID, step)
+	if err != nil {
+		return err
+	}
+	if !accepted {
+		return domain.ErrInvalidMFACode
+	}
+
+	return nil
+}
+
+func (s mfaService) issueRecoveryCodes(personID string, now time.Time) ([]string, error) {
+	count := s.config.MFA.RecoveryCodes()
+	codes := make([]string, 0, count)
+	stored := make([]domain.MFARecoveryCode, 0, count)
+
+	for range count {
+		code, err := newRecoveryCode()
+		if err != nil {
+			return nil, domain.ErrMFACannotSave
+		}
+
+		codes = append(codes, code)
+		stored = append(stored, domain.MFARecoveryCode{
+			ID:        uuid.New().String(),
+			PersonID:  personID,
+			CodeHash:  hashRecoveryCode(personID, code),
+			CreatedAt: now,
+		})
+	}
+
+	if err := s.factors.ReplaceRecoveryCodes(personID, stored); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (s mfaService) getPerson(personID string) (*domain.Person, error) {
+	person, err := s.repository.GetPersonByID(personID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPersonNotFound) {
+			return nil, err
+		}
+		return nil, domain.ErrUserCannotGet
+	}
+	return person, nil
+}
+
+// newRecoveryCode returns a code like "ABCDE-FGH23". The alphabet has 32
+// symbols, so taking each random byte modulo 32 is unbiased.
+func newRecoveryCode() (string, error) {
+	raw := make([]byte, recoveryCodeLength)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+
+	var code strings.Builder
+	for i, b := range raw {
+		if i == recoveryCodeLength/2 {
+			code.WriteByte('-')
+		}
+		code.WriteByte(recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)])
+	}
+	return code.String(), nil
+}
+
+func hashRecoveryCode(personID, code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(personID + ":" + normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+func isNumeric(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/oidc"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/password"
+	"github.com/google/uuid"
+)
+
+type oidcService struct {
+	repository ports.Repository
+	identities ports.ExternalIdentityRepository
+	providers  map[string]oidc.Provider
+	auth       ports.AuthService
+	hasher     password.Hasher
+	config     *config.Config
+}
+
+func NewOIDCService(repo ports.Repository, identities ports.ExternalIdentityRepository, providers []oidc.Provider, auth ports.AuthService, hasher password.Hasher, cfg *config.Config) ports.OIDCService {
+	byName := make(map[string]oidc.Provider, len(providers))
+	for _, provider := range providers {
+		byName[provider.Name()] = provider
+	}
+
+	return &oidcService{
+		repository: repo,
+		identities: identities,
+		providers:  byName,
+		auth:       auth,
+		hasher:     hasher,
+		config:     cfg,
+	}
+}
+
+func (s oidcService) BeginLogin(providerName string) (string, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return "", domain.ErrOIDCProviderNotFound
+	}
+
+	state, _, err := newOpaqueToken()
+	if err != nil {
+		return "", domain.ErrTokenCannotCreate
+	}
+	nonce, _, err := newOpaqueToken()
+	if err != nil {
+		return "", domain.ErrTokenCannotCreate
+	}
+	codeVerifier, _, err := newOpaqueToken()
+	if err != nil {
+		return "", domain.ErrTokenCannotCreate
+	}
+
+	now := time.Now()
+	if err := s.identities.DeleteExpiredLoginStates(now); err != nil {
+		slog.Warn("Error deleting expired oidc login states", slog.String("error", err.Error()))
+	}
+
+	err = s.identities.SaveLoginState(domain.OIDCLoginState{
+		State:        state,
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    now.Add(s.config.OIDC.StateTTL()),
+		CreatedAt:    now,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	challenge := sha256.Sum256([]byte(codeVerifier))
+	return provider.AuthorizationURL(oidc.AuthorizationRequest{
+		State:         state,
+		Nonce:         nonce,
+		CodeChallenge: base64.RawURLEncoding.EncodeToString(challenge[:]),
+	})
+}
+
+func (s oidcService) CompleteLogin(providerName, code, state string, client domain.ClientInfo) (domain.LoginResult, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return domain.LoginResult{}, domain.ErrOIDCProviderNotFound
+	}
+
+	loginState, err := s.identities.ConsumeLoginState(state)
+	if err != nil {
+		return domain.LoginResult{}, err
+	}
+
+	if loginState.Provider != providerName || loginState.IsExpired(time.Now()) {
+		return domain.LoginResult{}, domain.ErrOIDCInvalidState
+	}
+
+	identity, err := provider.Exchange(code, loginState.CodeVerifier, loginState.Nonce)
+	if err != nil {
+		slog.Warn("Error completing oidc login",
+			slog.String("provider", providerName),
+			slog.String("error", err.Error()))
+		return domain.LoginResult{}, domain.ErrOIDCExchangeFailed
+	}
+
+	personID, err := s.resolvePerson(providerName, identity)
+	if err != nil {
+		return domain.LoginResult{}, err
+	}
+
+	return s.auth.LoginExternal(personID, client)
+}
+
+// resolvePerson finds the person behind an external identity. Unknown
+// identities are linked by verified email, and only to accounts that have
+// verified that same email, so nobody can pre-register someone else's
+// address and wait for them to sign in with a provider.
+func (s oidcService) resolvePerson(providerName string, identity oidc.Identity) (string, error) {
+	linked, err := s.identities.Get(providerName, identity.Subject)
+	if err == nil {
+		return linked.PersonID, nil
+	}
+	if !errors.Is(err, domain.ErrExternalIdentityNotFound) {
+		return "", err
+	}
+
+	if identity.Email == "" || !identity.EmailVerified {
+		return "", domain.ErrOIDCEmailNotVerified
+	}
+
+	person, err := s.repository.GetPersonByEmail(identity.Email)
+	switch {
+	case err == nil:
+		if !person.EmailVerified {
+			return "", domain.ErrOIDCAccountNotLinkable
+		}
+	case errors.Is(err, domain.ErrPersonNotFound):
+		person, err = s.createPerson(identity)
+		if err != nil {
+			return "", err
+		}
+	default:
+		return "", domain.ErrUserCannotGet
+	}
+
+	err = s.identities.Save(domain.ExternalIdentity{
+		Provider:  providerName,
+		Subject:   identity.Subject,
+		PersonID:  person.ID,
+		Email:     identity.Email,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	slog.Info("Linked external identity",
+		slog.String("provider", providerName),
+		slog.String("person_id", person.ID))
+
+	return person.ID, nil
+}
+
+// createPerson registers a passenger from the provider's profile. The random
+// password cannot be used to log in; the person can set one through the
+// password reset flow.
+func (s oidcService) createPerson(identity oidc.Identity) (*domain.Person, error) {
+	person := domain.Person{
+		FirstName:     identity.GivenName,
+		LastName:      identity.FamilyName,
+		Email:         domain.NormalizeEmail(identity.Email),
+		EmailVerified: true,
+		Password:      uuid.New().String(),
+		Role:          domain.DefaultRole,
+		Status:        domain.AccountStatusActive,
+	}
+	person.SetID()
+	person.MarkCreated(time.Now())
+
+	if err := person.HashPassword(s.hasher); err != nil {
+		return nil, err
+	}
+
+	if err := s.repository.Save(person); err != nil {
+		return nil, err
+	}
+
+	return &person, nil
+}
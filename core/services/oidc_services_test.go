@@ -0,0 +1,279 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/oidc"
+	oidcAdapter "github.com/EstebanGitPro/motogo-backend/platform/oidc"
+	"github.com/EstebanGitPro/motogo-backend/platform/oidc/fakeidp"
+)
+
+const (
+	testProviderName = "fake"
+	testIssuer       = "https://idp.test/fake"
+	testClientID     = "motogo"
+	testClientSecret = "secret"
+	testRedirectURL  = "https://api.test/auth/oidc/fake/callback"
+)
+
+// The in-memory ports implement what the OIDC login touches; any other call
+// panics through the embedded nil interface.
+type memoryPersonRepository struct {
+	ports.Repository
+	persons map[string]domain.Person
+}
+
+func (r *memoryPersonRepository) Save(person domain.Person) error {
+	r.persons[person.ID] = person
+	return nil
+}
+
+func (r *memoryPersonRepository) GetPersonByEmail(email string) (*domain.Person, error) {
+	for _, person := range r.persons {
+		if person.Email == email {
+			return &person, nil
+		}
+	}
+	return nil, domain.ErrPersonNotFound
+}
+
+type memoryIdentityRepository struct {
+	identities map[string]domain.ExternalIdentity
+	states     map[string]domain.OIDCLoginState
+}
+
+func (r *memoryIdentityRepository) Save(identity domain.ExternalIdentity) error {
+	r.identities[identity.Provider+"/"+identity.Subject] = identity
+	return nil
+}
+
+func (r *memoryIdentityRepository) Get(provider, subject string) (*domain.ExternalIdentity, error) {
+	identity, ok := r.identities[provider+"/"+subject]
+	if !ok {
+		return nil, domain.ErrExternalIdentityNotFound
+	}
+	return &identity, nil
+}
+
+func (r *memoryIdentityRepository) ListByPerson(personID string) ([]domain.ExternalIdentity, error) {
+	var identities []domain.ExternalIdentity
+	for _, identity := range r.identities {
+		if identity.PersonID == personID {
+			identities = append(identities, identity)
+		}
+	}
+	return identities, nil
+}
+
+func (r *memoryIdentityRepository) SaveLoginState(state domain.OIDCLoginState) error {
+	r.states[state.State] = state
+	return nil
+}
+
+func (r *memoryIdentityRepository) ConsumeLoginState(state string) (*domain.OIDCLoginState, error) {
+	loginState, ok := r.states[state]
+	if !ok {
+		return nil, domain.ErrOIDCInvalidState
+	}
+	delete(r.states, state)
+	return &loginState, nil
+}
+
+func (r *memoryIdentityRepository) DeleteExpiredLoginStates(before time.Time) error {
+	for key, state := range r.states {
+		if state.IsExpired(before) {
+			delete(r.states, key)
+		}
+	}
+	return nil
+}
+
+type recordingAuthService struct {
+	ports.AuthService
+	loggedIn []string
+}
+
+func (a *recordingAuthService) LoginExternal(personID string, client domain.ClientInfo) (domain.LoginResult, error) {
+	a.loggedIn = append(a.loggedIn, personID)
+	return domain.LoginResult{Tokens: &domain.AuthTokens{}}, nil
+}
+
+type plainHasher struct{}
+
+func (plainHasher) Hash(password string) (string, error) {
+	return "hashed:" + password, nil
+}
+
+func (plainHasher) Verify(hash, password string) (bool, bool, error) {
+	return hash == "hashed:"+password, false, nil
+}
+
+type oidcTestEnv struct {
+	service    ports.OIDCService
+	idp        *fakeidp.Server
+	persons    *memoryPersonRepository
+	identities *memoryIdentityRepository
+	auth       *recordingAuthService
+}
+
+func newOIDCTestEnv(t *testing.T) oidcTestEnv {
+	t.Helper()
+
+	idp, err := fakeidp.New(testIssuer, testClientID, testClientSecret)
+	if err != nil {
+		t.Fatalf("creating fake identity provider: %v", err)
+	}
+
+	provider := oidcAdapter.NewProvider(oidcAdapter.ProviderConfig{
+		Name:         testProviderName,
+		Issuer:       testIssuer,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+	}, idp.Client())
+
+	env := oidcTestEnv{
+		idp:        idp,
+		persons:    &memoryPersonRepository{persons: make(map[string]domain.Person)},
+		identities: &memoryIdentityRepository{identities: make(map[string]domain.ExternalIdentity), states: make(map[string]domain.OIDCLoginState)},
+		auth:       &recordingAuthService{},
+	}
+	env.service = NewOIDCService(env.persons, env.identities, []oidc.Provider{provider}, env.auth, plainHasher{}, &config.Config{})
+	return env
+}
+
+// authorize follows the authorization URL on the fake identity provider and
+// returns the code and state it redirects back with.
+func (env oidcTestEnv) authorize(t *testing.T, authorizationURL string) (string, string) {
+	t.Helper()
+
+	client := env.idp.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	response, err := client.Get(authorizationURL)
+	if err != nil {
+		t.Fatalf("authorizing: %v", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusFound {
+		t.Fatalf("authorize status = %d, want %d", response.StatusCode, http.StatusFound)
+	}
+
+	callback, err := url.Parse(response.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("parsing callback: %v", err)
+	}
+	if got := callback.Scheme + "://" + callback.Host + callback.Path; got != testRedirectURL {
+		t.Fatalf("callback = %s, want %s", got, testRedirectURL)
+	}
+	return callback.Query().Get("code"), callback.Query().Get("state")
+}
+
+func (env oidcTestEnv) login(t *testing.T) (domain.LoginResult, error) {
+	t.Helper()
+
+	authorizationURL, err := env.service.BeginLogin(testProviderName)
+	if err != nil {
+		t.Fatalf("BeginLogin: %v", err)
+	}
+
+	code, state := env.authorize(t, authorizationURL)
+	return env.service.CompleteLogin(testProviderName, code, state, domain.ClientInfo{})
+}
+
+func TestOIDCLoginCreatesAndLinksPerson(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	env.idp.SetUser(fakeidp.User{
+		Subject:       "subject-1",
+		Email:         "rider@example.com",
+		EmailVerified: true,
+		GivenName:     "Ana",
+		FamilyName:    "Rider",
+	})
+
+	result, err := env.login(t)
+	if err != nil {
+		t.Fatalf("CompleteLogin: %v", err)
+	}
+	if result.Tokens == nil {
+		t.Fatal("CompleteLogin returned no tokens")
+	}
+
+	person, err := env.persons.GetPersonByEmail("rider@example.com")
+	if err != nil {
+		t.Fatalf("person was not created: %v", err)
+	}
+	if !person.EmailVerified || person.Role != domain.DefaultRole || person.FirstName != "Ana" {
+		t.Errorf("created person = %+v", person)
+	}
+
+	identity, err := env.identities.Get(testProviderName, "subject-1")
+	if err != nil {
+		t.Fatalf("identity was not linked: %v", err)
+	}
+	if identity.PersonID != person.ID {
+		t.Errorf("identity linked to %s, want %s", identity.PersonID, person.ID)
+	}
+
+	if _, err := env.login(t); err != nil {
+		t.Fatalf("second CompleteLogin: %v", err)
+	}
+	if len(env.persons.persons) != 1 {
+		t.Errorf("persons = %d after logging in twice, want 1", len(env.persons.persons))
+	}
+	if len(env.auth.loggedIn) != 2 || env.auth.loggedIn[0] != person.ID || env.auth.loggedIn[1] != person.ID {
+		t.Errorf("logged in = %v, want %s twice", env.auth.loggedIn, person.ID)
+	}
+}
+
+func TestOIDCLoginRejectsReusedState(t *testing.T) {
+	env := newOIDCTestEnv(t)
+
+	authorizationURL, err := env.service.BeginLogin(testProviderName)
+	if err != nil {
+		t.Fatalf("BeginLogin: %v", err)
+	}
+	code, state := env.authorize(t, authorizationURL)
+
+	if _, err := env.service.CompleteLogin(testProviderName, code, state, domain.ClientInfo{}); err != nil {
+		t.Fatalf("CompleteLogin: %v", err)
+	}
+	if _, err := env.service.CompleteLogin(testProviderName, code, state, domain.ClientInfo{}); !errors.Is(err, domain.ErrOIDCInvalidState) {
+		t.Errorf("reused state error = %v, want %v", err, domain.ErrOIDCInvalidState)
+	}
+}
+
+func TestOIDCLoginRejectsUnverifiedEmail(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	env.idp.SetUser(fakeidp.User{Subject: "subject-2", Email: "new@example.com"})
+
+	if _, err := env.login(t); !errors.Is(err, domain.ErrOIDCEmailNotVerified) {
+		t.Errorf("error = %v, want %v", err, domain.ErrOIDCEmailNotVerified)
+	}
+	if len(env.persons.persons) != 0 {
+		t.Errorf("persons = %d, want 0", len(env.persons.persons))
+	}
+}
+
+func TestOIDCLoginDoesNotLinkUnverifiedAccount(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	env.persons.persons["existing"] = domain.Person{ID: "existing", Email: "taken@example.com"}
+	env.idp.SetUser(fakeidp.User{Subject: "subject-3", Email: "taken@example.com", EmailVerified: true})
+
+	if _, err := env.login(t); !errors.Is(err, domain.ErrOIDCAccountNotLinkable) {
+		t.Errorf("error = %v, want %v", err, domain.ErrOIDCAccountNotLinkable)
+	}
+	if _, err := env.identities.Get(testProviderName, "subject-3"); !errors.Is(err, domain.ErrExternalIdentityNotFound) {
+		t.Errorf("identity lookup error = %v, want %v", err, domain.ErrExternalIdentityNotFound)
+	}
+}
@@ -0,0 +1,158 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/mailer"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/password"
+)
+
+type passwordService struct {
+	repository    ports.Repository
+	tokens        ports.VerificationTokenRepository
+	refreshTokens ports.RefreshTokenRepository
+	sessions      ports.SessionRepository
+	mailer        mailer.Mailer
+	hasher        password.Hasher
+	throttle      requestThrottle
+	config        *config.Config
+}
+
+func NewPasswordService(repo ports.Repository, tokens ports.VerificationTokenRepository, refreshTokens ports.RefreshTokenRepository, sessions ports.SessionRepository, m mailer.Mailer, hasher password.Hasher, attempts ports.LoginAttemptStore, cfg *config.Config) ports.PasswordService {
+	return &passwordService{
+		repository:    repo,
+		tokens:        tokens,
+		refreshTokens: refreshTokens,
+		sessions:      sessions,
+		mailer:        m,
+		hasher:        hasher,
+		throttle:      newRequestThrottle(attempts, cfg.PasswordReset.RequestWindow()),
+		config:        cfg,
+	}
+}
+
+// RequestPasswordReset never reports whether the email belongs to an account.
+// The lookup and the email happen in the background, so the answer takes as
+// long for unknown emails as for known ones.
+func (s passwordService) RequestPasswordReset(email, clientIP string) error {
+	err := s.throttle.allow(map[string]int{
+		domain.PasswordResetEmailKey(email): s.config.PasswordReset.EmailRequestLimit(),
+		domain.PasswordResetIPKey(clientIP): s.config.PasswordReset.IPRequestLimit(),
+	})
+	if err != nil {
+		return err
+	}
+
+	go s.sendPasswordReset(email)
+	return nil
+}
+
+// sendPasswordReset issues a reset token and mails it. Failures are only
+// logged, the caller has already been answered.
+func (s passwordService) sendPasswordReset(email string) {
+	person, err := s.repository.GetPersonByEmail(email)
+	if err != nil {
+		if !errors.Is(err, domain.ErrPersonNotFound) {
+			slog.Error("Error looking up person for password reset", slog.String("error", err.Error()))
+		}
+		return
+	}
+
+	rawToken, err := issueVerificationToken(s.tokens, person.ID, domain.VerificationPurposePasswordReset, s.config.PasswordReset.TokenTTL())
+	if err != nil {
+		slog.Error("Error issuing password reset token",
+			slog.String("person_id", person.ID),
+			slog.String("error", err.Error()))
+		return
+	}
+
+	link, err := buildTokenLink(s.config.PasswordReset.BaseURL, rawToken)
+	if err != nil {
+		slog.Error("Error building password reset link", slog.String("error", err.Error()))
+		return
+	}
+
+	if err := s.mailer.Send(passwordResetMessage(*person, link)); err != nil {
+		slog.Error("Error sending password reset email",
+			slog.String("person_id", person.ID),
+			slog.String("error", err.Error()))
+	}
+}
+
+// ResetPassword only spends the token once the new password passed the
+// policy and was hashed, so a rejected password can be retried with the same
+// link. The token is marked used right before the hash is stored, which keeps
+// two concurrent resets from both going through.
+func (s passwordService) ResetPassword(token, newPassword string) error {
+	stored, err := lookupVerificationToken(s.tokens, token, domain.VerificationPurposePasswordReset)
+	if err != nil {
+		return err
+	}
+
+	person, err := s.repository.GetPersonByID(stored.PersonID)
+	if err != nil {
+		return err
+	}
+
+	if err := domain.ValidatePassword("password", newPassword, *person); err != nil {
+		return err
+	}
+
+	hash, err := s.hashPassword(*person, newPassword)
+	if err != nil {
+		return err
+	}
+
+	if err := markVerificationTokenUsed(s.tokens, stored.ID); err != nil {
+		return err
+	}
+
+	return s.storePassword(person.ID, hash)
+}
+
+func (s passwordService) ChangePassword(personID, currentPassword, newPassword string) error {
+	person, err := s.repository.GetPersonByID(personID)
+	if err != nil {
+		return err
+	}
+
+	if match, _ := person.CheckPassword(s.hasher, currentPassword); !match {
+		return domain.ErrInvalidCurrentPassword
+	}
+
+	if err := domain.ValidatePassword("new_password", newPassword, *person); err != nil {
+		return err
+	}
+
+	return s.setPassword(*person, newPassword)
+}
+
+// setPassword stores the new hash and signs the person out everywhere.
+func (s passwordService) setPassword(person domain.Person, newPassword string) error {
+	hash, err := s.hashPassword(person, newPassword)
+	if err != nil {
+		return err
+	}
+
+	return s.storePassword(person.ID, hash)
+}
+
+func (s passwordService) hashPassword(person domain.Person, newPassword string) (string, error) {
+	person.Password = newPassword
+	if err := person.HashPassword(s.hasher); err != nil {
+		return "", err
+	}
+	return person.Password, nil
+}
+
+func (s passwordService) storePassword(personID, hash string) error {
+	if err := s.repository.UpdatePassword(personID, hash); err != nil {
+		return err
+	}
+
+	return revokeAllSessions(s.sessions, s.refreshTokens, personID)
+}
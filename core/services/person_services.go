@@ -1,19 +1,31 @@
 package services
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"log/slog"
+	"time"
+
 	"github.com/EstebanGitPro/motogo-backend/core/domain"
 	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/password"
 	"github.com/EstebanGitPro/motogo-backend/config"
 )
 
 type service struct {
 	repository     ports.Repository
+	verification   ports.VerificationService
+	phone          ports.PhoneVerificationService
+	hasher         password.Hasher
 	config         *config.Config
 }
 
-func NewService(repo ports.Repository, cfg *config.Config) ports.Service {
+func NewService(repo ports.Repository, verification ports.VerificationService, phone ports.PhoneVerificationService, hasher password.Hasher, cfg *config.Config) ports.Service {
 	return &service{
 		repository:     repo,
+		verification:   verification,
+		phone:          phone,
+		hasher:         hasher,
 		config:         cfg,
 	}			
 }
@@ -21,14 +33,43 @@ func NewService(repo ports.Repository, cfg *config.Config) ports.Service {
 
 func (s service) RegisterPerson(person domain.Person) (domain.Person, error) {
 
+	if person.Role == "" {
+		person.Role = domain.DefaultRole
+	}
+	if !domain.IsSelfAssignableRole(person.Role) {
+		return domain.Person{}, domain.ErrRoleNotAllowed
+	}
+
+	person.Email = domain.NormalizeEmail(person.Email)
+
+	if person.DocumentType == "" {
+		person.DocumentType = domain.DefaultDocumentType
+	}
+	person.IdentityNumber = domain.NormalizeIdentityNumber(person.DocumentType, person.IdentityNumber)
+	if err := domain.ValidateIdentityDocument(person.DocumentType, person.IdentityNumber); err != nil {
+		return domain.Person{}, err
+	}
+
+	if err := person.NormalizePhoneNumber(); err != nil {
+		return domain.Person{}, err
+	}
+
+	if err := domain.ValidatePassword("password", person.Password, person); err != nil {
+		return domain.Person{}, err
+	}
+
 	existingPerson, err := s.repository.GetPersonByEmail(person.Email)
 	if err == nil && existingPerson != nil {
 		return domain.Person{},domain.ErrDuplicateUser
 	}
 
 	person.SetID()
+	person.MarkCreated(time.Now())
+	person.Status = domain.AccountStatusActive
+	person.EmailVerified = false
+	person.PhoneNumberVerified = false
 
-	if err := person.HashPassword(); err != nil {
+	if err := person.HashPassword(s.hasher); err != nil {
 		return domain.Person{}, err
 	}
 
@@ -37,9 +78,225 @@ func (s service) RegisterPerson(person domain.Person) (domain.Person, error) {
 		return domain.Person{}, err
 	}
 
+	if err := s.verification.SendEmailVerification(person); err != nil {
+		slog.Warn("Person registered without verification email",
+			slog.String("person_id", person.ID),
+			slog.String("error", err.Error()))
+	}
+
 	return person, nil
 }
 
 func (s service) GetPersonByEmail(email string) (*domain.Person, error) {
 	return s.repository.GetPersonByEmail(email)
-}
\ No newline at end of file
+}
+
+func (s service) AssignRole(id, role string, version int64) (*domain.Person, error) {
+	if !domain.IsValidRole(role) {
+		return nil, domain.ErrInvalidRole
+	}
+
+	person, err := s.repository.GetPersonByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if person.Version != version {
+		return nil, domain.ErrVersionConflict
+	}
+
+	if err := s.repository.UpdateRole(person.ID, role, version); err != nil {
+		return nil, err
+	}
+
+	person.Role = role
+	person.Touch(time.Now())
+	return person, nil
+}
+
+// SetAccountStatus leaves accounts waiting to be deleted alone: the purge
+// would still anonymize them whatever their status. The person cancels the
+// deletion first.
+func (s service) SetAccountStatus(actorRole, id string, change domain.AccountStatusChange, version int64) (*domain.Person, error) {
+	now := time.Now()
+	if err := change.Validate(now); err != nil {
+		return nil, err
+	}
+
+	person, err := s.repository.GetPersonByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if person.Version != version {
+		return nil, domain.ErrVersionConflict
+	}
+	if !domain.CanManageRole(actorRole, person.Role) {
+		return nil, domain.ErrRoleOutranksCaller
+	}
+	if person.Status == domain.AccountStatusPendingDeletion {
+		return nil, domain.ErrAccountDeletionPending
+	}
+	if !person.CanOverrideStatus(actorRole, now) {
+		return nil, domain.ErrStatusSetByHigher
+	}
+
+	change.SetByRole = actorRole
+	if err := s.repository.UpdateStatus(person.ID, change, version); err != nil {
+		return nil, err
+	}
+
+	slog.Info("Account status changed",
+		slog.String("person_id", person.ID),
+		slog.String("from", person.Status),
+		slog.String("to", change.Status))
+
+	person.Status = change.Status
+	person.StatusReason = change.Reason
+	person.StatusExpiresAt = change.ExpiresAt
+	person.StatusSetByRole = change.SetByRole
+	person.Touch(now)
+	return person, nil
+}
+
+func (s service) CheckAccountStatus(id string) (*domain.Person, error) {
+	person, err := s.repository.GetPersonByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := person.CheckAccountStatus(time.Now()); err != nil {
+		return nil, err
+	}
+	return person, nil
+}
+
+func (s service) GetPersonByID(id string) (*domain.Person, error) {
+	return s.repository.GetPersonByID(id)
+}
+
+// UpdatePerson changes the profile of a person. A new email is sent a fresh
+// verification link and a new phone number an OTP; failing to send either
+// does not undo the update, the person can ask for them again.
+func (s service) UpdatePerson(id string, update domain.PersonUpdate, version int64) (*domain.Person, error) {
+	person, err := s.repository.GetPersonByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if person.Version != version {
+		return nil, domain.ErrVersionConflict
+	}
+
+	if update.Email != nil {
+		email := domain.NormalizeEmail(*update.Email)
+		update.Email = &email
+	}
+
+	if update.PhoneNumber != nil {
+		country := person.Country
+		if update.Country != nil {
+			country = *update.Country
+		}
+		phone, err := domain.NormalizePhoneNumber(*update.PhoneNumber, country)
+		if err != nil {
+			return nil, err
+		}
+		update.PhoneNumber, update.Country = &phone.E164, &phone.Country
+	}
+
+	emailChanged, phoneChanged := person.Apply(update)
+
+	if emailChanged {
+		existingPerson, err := s.repository.GetPersonByEmail(person.Email)
+		if err == nil && existingPerson.ID != person.ID {
+			return nil, domain.ErrDuplicateUser
+		}
+	}
+
+	person.UpdatedAt = time.Now()
+	if err := s.repository.Update(*person); err != nil {
+		return nil, err
+	}
+	person.Version++
+
+	if emailChanged {
+		if err := s.verification.SendEmailVerification(*person); err != nil {
+			slog.Warn("Email changed without verification email",
+				slog.String("person_id", person.ID),
+				slog.String("error", err.Error()))
+		}
+	}
+
+	if phoneChanged {
+		if err := s.phone.RequestPhoneOTP(person.ID); err != nil {
+			slog.Warn("Phone number changed without verification code",
+				slog.String("person_id", person.ID),
+				slog.String("error", err.Error()))
+		}
+	}
+
+	return person, nil
+}
+
+func (s service) ListPersons(filter domain.PersonFilter, sortKey, cursor string, limit int) (domain.PersonPage, error) {
+	sort, err := domain.ParsePersonSort(sortKey)
+	if err != nil {
+		return domain.PersonPage{}, err
+	}
+
+	if limit <= 0 {
+		limit = domain.DefaultPersonPageSize
+	}
+	if limit > domain.MaxPersonPageSize {
+		limit = domain.MaxPersonPageSize
+	}
+
+	query := domain.PersonQuery{Filter: filter, Sort: sort, Limit: limit + 1}
+	if cursor != "" {
+		after, err := decodePersonCursor(cursor, sort)
+		if err != nil {
+			return domain.PersonPage{}, err
+		}
+		query.After = after
+	}
+
+	persons, err := s.repository.ListPersons(query)
+	if err != nil {
+		return domain.PersonPage{}, err
+	}
+
+	page := domain.PersonPage{Persons: persons}
+	if len(persons) > limit {
+		page.Persons = persons[:limit]
+		last := page.Persons[limit-1]
+		page.NextCursor = encodePersonCursor(sort, domain.PersonCursor{Value: sort.ValueOf(last), ID: last.ID})
+	}
+
+	return page, nil
+}
+
+// personCursor is the encoded form of a listing position. It records the
+// sort it was issued for, so it cannot be replayed against another order.
+type personCursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	ID    string `json:"id"`
+}
+
+func encodePersonCursor(sort domain.PersonSort, cursor domain.PersonCursor) string {
+	raw, _ := json.Marshal(personCursor{Sort: sort.Key(), Value: cursor.Value, ID: cursor.ID})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodePersonCursor(encoded string, sort domain.PersonSort) (*domain.PersonCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	var cursor personCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.ID == "" || cursor.Sort != sort.Key() {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	return &domain.PersonCursor{Value: cursor.Value, ID: cursor.ID}, nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+func TestPersonCursorRoundTrip(t *testing.T) {
+	sort := domain.PersonSort{Field: domain.PersonSortEmail, Descending: true}
+	want := domain.PersonCursor{Value: "ana@example.com", ID: "person-1"}
+
+	got, err := decodePersonCursor(encodePersonCursor(sort, want), sort)
+	if err != nil {
+		t.Fatalf("decodePersonCursor: %v", err)
+	}
+	if *got != want {
+		t.Errorf("decoded cursor = %+v, want %+v", *got, want)
+	}
+}
+
+func TestDecodePersonCursorRejectsOtherSort(t *testing.T) {
+	issued := domain.PersonSort{Field: domain.PersonSortLastName}
+	encoded := encodePersonCursor(issued, domain.PersonCursor{Value: "Rider", ID: "person-1"})
+
+	tests := []struct {
+		name string
+		sort domain.PersonSort
+	}{
+		{"other field", domain.PersonSort{Field: domain.PersonSortFirstName}},
+		{"other direction", domain.PersonSort{Field: domain.PersonSortLastName, Descending: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodePersonCursor(encoded, tt.sort); !errors.Is(err, domain.ErrInvalidCursor) {
+				t.Errorf("decodePersonCursor under %q = %v, want %v", tt.sort.Key(), err, domain.ErrInvalidCursor)
+			}
+		})
+	}
+}
+
+func TestDecodePersonCursorRejectsTampering(t *testing.T) {
+	sort := domain.PersonSort{Field: domain.PersonSortLastName}
+	encoded := encodePersonCursor(sort, domain.PersonCursor{Value: "Rider", ID: "person-1"})
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", encoded + "*"},
+		{"truncated", encoded[:len(encoded)-4]},
+		{"not json", encode("last_name|Rider|person-1")},
+		{"sort rewritten", encode(`{"s":"first_name","v":"Rider","id":"person-1"}`)},
+		{"missing id", encode(`{"s":"last_name","v":"Rider"}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodePersonCursor(tt.cursor, sort); !errors.Is(err, domain.ErrInvalidCursor) {
+				t.Errorf("decodePersonCursor(%q) = %v, want %v", tt.cursor, err, domain.ErrInvalidCursor)
+			}
+		})
+	}
+}
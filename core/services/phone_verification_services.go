@@ -0,0 +1,184 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/sms"
+	"github.com/google/uuid"
+)
+
+const otpDigits = 6
+
+type phoneVerificationService struct {
+	repository ports.Repository
+	otps       ports.PhoneOTPRepository
+	sender     sms.Sender
+	config     *config.Config
+}
+
+func NewPhoneVerificationService(repo ports.Repository, otps ports.PhoneOTPRepository, sender sms.Sender, cfg *config.Config) ports.PhoneVerificationService {
+	return &phoneVerificationService{
+		repository: repo,
+		otps:       otps,
+		sender:     sender,
+		config:     cfg,
+	}
+}
+
+func (s phoneVerificationService) RequestPhoneOTP(personID string) error {
+	person, err := s.getPerson(personID)
+	if err != nil {
+		return err
+	}
+
+	if person.PhoneNumberVerified {
+		return domain.ErrPhoneAlreadyVerified
+	}
+
+	now := time.Now()
+	latest, err := s.otps.GetLatestByPerson(personID)
+	if err != nil && !errors.Is(err, domain.ErrOTPNotFound) {
+		return err
+	}
+	if latest != nil && now.Before(latest.CreatedAt.Add(s.config.PhoneVerification.ResendCooldown())) {
+		return domain.ErrOTPResendCooldown
+	}
+
+	code, err := newOTPCode()
+	if err != nil {
+		return domain.ErrTokenCannotCreate
+	}
+
+	ttl := s.config.PhoneVerification.CodeTTL()
+	err = s.otps.Save(domain.PhoneOTP{
+		ID:          uuid.New().String(),
+		PersonID:    person.ID,
+		PhoneNumber: person.PhoneNumber,
+		CodeHash:    hashOTPCode(person.ID, person.PhoneNumber, code),
+		ExpiresAt:   now.Add(ttl),
+		CreatedAt:   now,
+	})
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Tu codigo de verificacion de MotoGo es %s. Vence en %d minutos.", code, int(ttl.Minutes()))
+	if err := s.sender.Send(person.PhoneNumber, message); err != nil {
+		slog.Error("Error sending verification sms",
+			slog.String("person_id", person.ID),
+			slog.String("error", err.Error()))
+		return domain.ErrSMSCannotSend
+	}
+
+	return nil
+}
+
+func (s phoneVerificationService) ConfirmPhoneOTP(personID, code string) error {
+	person, err := s.getPerson(personID)
+	if err != nil {
+		return err
+	}
+
+	if person.PhoneNumberVerified {
+		return domain.ErrPhoneAlreadyVerified
+	}
+
+	otp, err := s.otps.GetLatestByPerson(personID)
+	if err != nil {
+		return err
+	}
+
+	if otp.IsConsumed() || otp.PhoneNumber != person.PhoneNumber {
+		return domain.ErrOTPNotFound
+	}
+
+	now := time.Now()
+	if otp.IsExpired(now) {
+		return domain.ErrTokenExpired
+	}
+
+	// The attempt is counted before the code is compared, so every guess
+	// uses up one of the attempts even when requests race.
+	maxAttempts := s.config.PhoneVerification.MaxCodeAttempts()
+	counted, err := s.otps.IncrementAttempts(otp.ID, maxAttempts)
+	if err != nil {
+		return err
+	}
+	if !counted {
+		return domain.ErrOTPAttemptsExceeded
+	}
+
+	expected := hashOTPCode(person.ID, person.PhoneNumber, code)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(otp.CodeHash)) != 1 {
+		if otp.Attempts+1 >= maxAttempts {
+			return domain.ErrOTPAttemptsExceeded
+		}
+		return domain.ErrInvalidOTP
+	}
+
+	consumed, err := s.otps.MarkConsumed(otp.ID, now)
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return domain.ErrOTPNotFound
+	}
+
+	return s.repository.SetPhoneNumberVerified(person.ID)
+}
+
+func (s phoneVerificationService) EnsurePhoneVerified(personID string) error {
+	person, err := s.getPerson(personID)
+	if err != nil {
+		return err
+	}
+
+	if !person.PhoneNumberVerified {
+		return domain.ErrPhoneNotVerified
+	}
+
+	return nil
+}
+
+func (s phoneVerificationService) getPerson(personID string) (*domain.Person, error) {
+	person, err := s.repository.GetPersonByID(personID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPersonNotFound) {
+			return nil, domain.ErrPersonNotFound
+		}
+		return nil, domain.ErrUserCannotGet
+	}
+	return person, nil
+}
+
+func newOTPCode() (string, error) {
+	limit := big.NewInt(1)
+	for i := 0; i < otpDigits; i++ {
+		limit.Mul(limit, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", otpDigits, n.Int64()), nil
+}
+
+// hashOTPCode binds the code to the person and the phone it was sent to, so a
+// code issued for a previous number cannot confirm a new one.
+func hashOTPCode(personID, phoneNumber, code string) string {
+	sum := sha256.Sum256([]byte(personID + ":" + phoneNumber + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/imaging"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/storage"
+	"github.com/google/uuid"
+)
+
+type photoService struct {
+	repository ports.Repository
+	processor  imaging.Processor
+	storage    storage.ObjectStorage
+	config     *config.Config
+}
+
+func NewPhotoService(repo ports.Repository, processor imaging.Processor, objects storage.ObjectStorage, cfg *config.Config) ports.PhotoService {
+	return &photoService{
+		repository: repo,
+		processor:  processor,
+		storage:    objects,
+		config:     cfg,
+	}
+}
+
+func (s photoService) UploadProfilePhoto(personID string, data []byte, version int64) (*domain.Person, error) {
+	if int64(len(data)) > s.config.ProfilePhoto.MaxBytes() {
+		return nil, domain.ErrPhotoTooLarge
+	}
+
+	person, err := s.repository.GetPersonByID(personID)
+	if err != nil {
+		return nil, err
+	}
+	if person.Version != version {
+		return nil, domain.ErrVersionConflict
+	}
+
+	photo, thumbnail, err := s.processor.Process(data, s.config.ProfilePhoto.Dimension(), s.config.ProfilePhoto.Thumbnail())
+	if err != nil {
+		return nil, err
+	}
+
+	key := domain.ProfilePhotoKey(personID, uuid.New().String())
+	thumbnailKey := domain.ProfilePhotoThumbnailKey(key)
+
+	url, err := s.storage.Put(storage.Object{Key: key, ContentType: photo.ContentType, Data: photo.Data})
+	if err != nil {
+		slog.Error("Error storing profile photo", slog.String("person_id", personID), slog.String("error", err.Error()))
+		return nil, domain.ErrPhotoCannotStore
+	}
+
+	thumbnailURL, err := s.storage.Put(storage.Object{Key: thumbnailKey, ContentType: thumbnail.ContentType, Data: thumbnail.Data})
+	if err != nil {
+		slog.Error("Error storing profile photo thumbnail", slog.String("person_id", personID), slog.String("error", err.Error()))
+		s.deleteObjects(key)
+		return nil, domain.ErrPhotoCannotStore
+	}
+
+	stored := domain.ProfilePhoto{Key: key, URL: url, ThumbnailURL: thumbnailURL}
+	if err := s.repository.UpdatePhoto(personID, stored, version); err != nil {
+		s.deleteObjects(key, thumbnailKey)
+		return nil, err
+	}
+
+	if person.HasPhoto() {
+		s.deleteObjects(person.PhotoKey, domain.ProfilePhotoThumbnailKey(person.PhotoKey))
+	}
+
+	person.SetPhoto(stored)
+	person.Touch(time.Now())
+	return person, nil
+}
+
+func (s photoService) DeleteProfilePhoto(personID string, version int64) (*domain.Person, error) {
+	person, err := s.repository.GetPersonByID(personID)
+	if err != nil {
+		return nil, err
+	}
+	if person.Version != version {
+		return nil, domain.ErrVersionConflict
+	}
+
+	if !person.HasPhoto() {
+		return nil, domain.ErrPhotoNotFound
+	}
+
+	if err := s.repository.UpdatePhoto(personID, domain.ProfilePhoto{}, version); err != nil {
+		return nil, err
+	}
+
+	s.deleteObjects(person.PhotoKey, domain.ProfilePhotoThumbnailKey(person.PhotoKey))
+
+	person.SetPhoto(domain.ProfilePhoto{})
+	person.Touch(time.Now())
+	return person, nil
+}
+
+func (s photoService) deleteObjects(keys ...string) {
+	deleteStoredObjects(s.storage, keys...)
+}
+
+// deleteStoredObjects removes objects no longer referenced by any person.
+// Failures only leave orphaned files behind, so they are logged and not
+// returned.
+func deleteStoredObjects(objects storage.ObjectStorage, keys ...string) {
+	for _, key := range keys {
+		if err := objects.Delete(key); err != nil {
+			slog.Warn("Error deleting stored object", slog.String("key", key), slog.String("error", err.Error()))
+		}
+	}
+}
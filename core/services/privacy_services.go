@@ -0,0 +1,185 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/storage"
+)
+
+// purgeBatchSize bounds how many accounts one purge pass anonymizes.
+const purgeBatchSize = 100
+
+type privacyService struct {
+	repository ports.Repository
+	deletions  ports.AccountDeletionRepository
+	sessions   ports.SessionRepository
+	identities ports.ExternalIdentityRepository
+	mfa        ports.MFARepository
+	storage    storage.ObjectStorage
+	config     *config.Config
+}
+
+func NewPrivacyService(repo ports.Repository, deletions ports.AccountDeletionRepository, sessions ports.SessionRepository, identities ports.ExternalIdentityRepository, mfa ports.MFARepository, objects storage.ObjectStorage, cfg *config.Config) ports.PrivacyService {
+	return &privacyService{
+		repository: repo,
+		deletions:  deletions,
+		sessions:   sessions,
+		identities: identities,
+		mfa:        mfa,
+		storage:    objects,
+		config:     cfg,
+	}
+}
+
+func (s privacyService) ExportPersonData(personID string) (domain.PersonDataExport, error) {
+	person, err := s.repository.GetPersonByID(personID)
+	if err != nil {
+		return domain.PersonDataExport{}, err
+	}
+
+	sessions, err := s.sessions.ListByPerson(personID)
+	if err != nil {
+		return domain.PersonDataExport{}, err
+	}
+
+	identities, err := s.identities.ListByPerson(personID)
+	if err != nil {
+		return domain.PersonDataExport{}, err
+	}
+
+	var mfaStatus domain.MFAStatus
+	factor, err := s.mfa.GetFactor(personID)
+	switch {
+	case err == nil:
+		mfaStatus = domain.MFAStatus{Enrolled: factor.IsConfirmed(), ConfirmedAt: factor.ConfirmedAt}
+	case !errors.Is(err, domain.ErrMFAFactorNotFound):
+		return domain.PersonDataExport{}, err
+	}
+
+	deletion, err := s.GetAccountDeletion(personID)
+	if err != nil {
+		return domain.PersonDataExport{}, err
+	}
+
+	return domain.PersonDataExport{
+		GeneratedAt:        time.Now(),
+		Person:             *person,
+		Sessions:           sessions,
+		ExternalIdentities: identities,
+		MFA:                mfaStatus,
+		Deletion:           deletion,
+	}, nil
+}
+
+func (s privacyService) RequestAccountDeletion(personID string) (domain.AccountDeletion, error) {
+	person, err := s.repository.GetPersonByID(personID)
+	if err != nil {
+		return domain.AccountDeletion{}, err
+	}
+
+	existing, err := s.deletions.Get(personID)
+	switch {
+	case err == nil && !existing.IsCompleted():
+		return domain.AccountDeletion{}, domain.ErrAccountDeletionAlreadyRequested
+	case err != nil && !errors.Is(err, domain.ErrAccountDeletionNotFound):
+		return domain.AccountDeletion{}, err
+	}
+
+	now := time.Now()
+	deletion := domain.AccountDeletion{
+		PersonID:     personID,
+		RequestedAt:  now,
+		ScheduledFor: now.Add(s.config.AccountDeletion.GracePeriod()),
+	}
+	if err := s.deletions.Save(deletion); err != nil {
+		return domain.AccountDeletion{}, err
+	}
+
+	if person.CurrentStatus(now) == domain.AccountStatusActive {
+		err := s.repository.UpdateStatus(personID, domain.AccountStatusChange{Status: domain.AccountStatusPendingDeletion}, person.Version)
+		if err != nil {
+			return domain.AccountDeletion{}, err
+		}
+	}
+
+	slog.Info("Account deletion requested",
+		slog.String("person_id", personID),
+		slog.Time("scheduled_for", deletion.ScheduledFor))
+
+	return deletion, nil
+}
+
+// GetAccountDeletion returns the pending request of the person, or nil when
+// there is none.
+func (s privacyService) GetAccountDeletion(personID string) (*domain.AccountDeletion, error) {
+	deletion, err := s.deletions.Get(personID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAccountDeletionNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if deletion.IsCompleted() {
+		return nil, nil
+	}
+	return deletion, nil
+}
+
+func (s privacyService) CancelAccountDeletion(personID string) error {
+	cancelled, err := s.deletions.Cancel(personID)
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return domain.ErrAccountDeletionNotFound
+	}
+
+	person, err := s.repository.GetPersonByID(personID)
+	if err != nil {
+		return err
+	}
+	if person.Status == domain.AccountStatusPendingDeletion {
+		if err := s.repository.UpdateStatus(personID, domain.AccountStatusChange{Status: domain.AccountStatusActive}, person.Version); err != nil {
+			return err
+		}
+	}
+
+	slog.Info("Account deletion cancelled", slog.String("person_id", personID))
+	return nil
+}
+
+func (s privacyService) PurgeDueAccounts(now time.Time) (int, error) {
+	purged := 0
+	for {
+		due, err := s.deletions.ListDue(now, purgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, deletion := range due {
+			person, err := s.repository.GetPersonByID(deletion.PersonID)
+			if err != nil {
+				return purged, err
+			}
+
+			if err := s.deletions.Anonymize(*person, now); err != nil {
+				return purged, err
+			}
+			if person.HasPhoto() {
+				deleteStoredObjects(s.storage, person.PhotoKey, domain.ProfilePhotoThumbnailKey(person.PhotoKey))
+			}
+
+			slog.Info("Account anonymized", slog.String("person_id", deletion.PersonID))
+			purged++
+		}
+
+		if len(due) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package services
+
+import (
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+)
+
+// requestThrottle limits how often an action that sends a message can be
+// triggered. Requests are counted per key in a sliding window, reusing the
+// login attempt store.
+type requestThrottle struct {
+	store  ports.LoginAttemptStore
+	window time.Duration
+}
+
+func newRequestThrottle(store ports.LoginAttemptStore, window time.Duration) requestThrottle {
+	return requestThrottle{store: store, window: window}
+}
+
+// allow records a request for every key, unless one of them already reached
+// its limit within the window.
+func (t requestThrottle) allow(limits map[string]int) error {
+	now := time.Now()
+
+	for key, limit := range limits {
+		requests, err := t.store.Failures(key, now.Add(-t.window))
+		if err != nil {
+			return err
+		}
+		if len(requests) >= limit {
+			return &domain.RetryAfterError{
+				Err:        domain.ErrTooManyEmailRequests,
+				RetryAfter: requests[len(requests)-limit].Add(t.window).Sub(now),
+			}
+		}
+	}
+
+	for key := range limits {
+		if err := t.store.RecordFailure(key, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/platform/loginattempt"
+)
+
+func TestRequestThrottleStopsAtLimit(t *testing.T) {
+	throttle := newRequestThrottle(loginattempt.NewMemoryStore(time.Hour), time.Hour)
+	limits := map[string]int{
+		domain.VerificationResendKey("Rider@Example.com"): 2,
+	}
+
+	for i := range 2 {
+		if err := throttle.allow(limits); err != nil {
+			t.Fatalf("request %d: %v", i+1, err)
+		}
+	}
+
+	// Keys use the canonical email, so changing its case does not help.
+	err := throttle.allow(map[string]int{domain.VerificationResendKey("rider@example.com"): 2})
+	if !errors.Is(err, domain.ErrTooManyEmailRequests) {
+		t.Fatalf("third request error = %v, want %v", err, domain.ErrTooManyEmailRequests)
+	}
+
+	var retryErr *domain.RetryAfterError
+	if !errors.As(err, &retryErr) || retryErr.RetryAfter <= 0 || retryErr.RetryAfter > time.Hour {
+		t.Errorf("retry after = %v, want within the window", err)
+	}
+}
+
+func TestRequestThrottleDoesNotCountRejectedRequests(t *testing.T) {
+	throttle := newRequestThrottle(loginattempt.NewMemoryStore(time.Hour), time.Hour)
+	emailKey := domain.PasswordResetEmailKey("rider@example.com")
+	ipKey := domain.PasswordResetIPKey("203.0.113.7")
+
+	if err := throttle.allow(map[string]int{emailKey: 1, ipKey: 5}); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	if err := throttle.allow(map[string]int{emailKey: 1, ipKey: 5}); !errors.Is(err, domain.ErrTooManyEmailRequests) {
+		t.Fatalf("second request error = %v, want %v", err, domain.ErrTooManyEmailRequests)
+	}
+
+	// The rejected request must not use up the IP allowance of other emails.
+	for i := range 4 {
+		otherEmail := domain.PasswordResetEmailKey("other" + string(rune('a'+i)) + "@example.com")
+		if err := throttle.allow(map[string]int{otherEmail: 1, ipKey: 5}); err != nil {
+			t.Fatalf("request for another email %d: %v", i+1, err)
+		}
+	}
+}
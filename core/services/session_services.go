@@ -0,0 +1,88 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+)
+
+// sessionTouchInterval limits how often a busy session writes its last-seen
+// time.
+const sessionTouchInterval = time.Minute
+
+type sessionService struct {
+	sessions      ports.SessionRepository
+	refreshTokens ports.RefreshTokenRepository
+}
+
+func NewSessionService(sessions ports.SessionRepository, refreshTokens ports.RefreshTokenRepository) ports.SessionService {
+	return &sessionService{
+		sessions:      sessions,
+		refreshTokens: refreshTokens,
+	}
+}
+
+func (s sessionService) ListSessions(personID string) ([]domain.Session, error) {
+	return s.sessions.ListActiveByPerson(personID)
+}
+
+func (s sessionService) RevokeSession(personID, sessionID string) error {
+	session, err := s.sessions.GetByID(sessionID)
+	if err != nil {
+		return err
+	}
+
+	if session.PersonID != personID || session.IsRevoked() {
+		return domain.ErrSessionNotFound
+	}
+
+	return revokeSession(s.sessions, s.refreshTokens, sessionID)
+}
+
+func (s sessionService) RevokeAllSessions(personID string) error {
+	return revokeAllSessions(s.sessions, s.refreshTokens, personID)
+}
+
+func (s sessionService) CheckSession(personID, sessionID, ip string) error {
+	session, err := s.sessions.GetByID(sessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return domain.ErrSessionRevoked
+		}
+		return err
+	}
+
+	if session.PersonID != personID || session.IsRevoked() {
+		return domain.ErrSessionRevoked
+	}
+
+	now := time.Now()
+	if now.Sub(session.LastSeenAt) >= sessionTouchInterval || session.IP != ip {
+		if err := s.sessions.Touch(sessionID, ip, now); err != nil {
+			slog.Warn("Error updating session last seen",
+				slog.String("session_id", sessionID),
+				slog.String("error", err.Error()))
+		}
+	}
+
+	return nil
+}
+
+// revokeSession ends a session together with its refresh token family so
+// that neither the access token nor a refresh can keep it alive.
+func revokeSession(sessions ports.SessionRepository, refreshTokens ports.RefreshTokenRepository, sessionID string) error {
+	if err := sessions.Revoke(sessionID, time.Now()); err != nil {
+		return err
+	}
+	return refreshTokens.RevokeFamily(sessionID)
+}
+
+func revokeAllSessions(sessions ports.SessionRepository, refreshTokens ports.RefreshTokenRepository, personID string) error {
+	if err := sessions.RevokeAllForPerson(personID, time.Now()); err != nil {
+		return err
+	}
+	return refreshTokens.RevokeAllForPerson(personID)
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
+)
+
+// keyPublicationDelay is how long a key change takes to reach everybody:
+// instances reload their keys every KeyReloadInterval and verifiers may
+// cache the published key set for KeySetMaxAge.
+const keyPublicationDelay = token.KeyReloadInterval + token.KeySetMaxAge
+
+type signingKeyService struct {
+	keys    ports.SigningKeyRepository
+	factory token.KeyFactory
+	keySet  token.KeySet
+	config  *config.Config
+}
+
+func NewSigningKeyService(keys ports.SigningKeyRepository, factory token.KeyFactory, keySet token.KeySet, cfg *config.Config) ports.SigningKeyService {
+	return &signingKeyService{
+		keys:    keys,
+		factory: factory,
+		keySet:  keySet,
+		config:  cfg,
+	}
+}
+
+// RotateSigningKey publishes a new key and retires the previous ones once it
+// takes over. The new key only signs after every instance had time to reload
+// it and verifiers holding a cached key set had time to refetch it, so other
+// services never reject tokens from it. The retired keys keep verifying for
+// the lifetime of the longest token they may have signed plus the same
+// delay, so nobody is logged out by a rotation. The very first key activates
+// at once: there is nothing else to sign with.
+func (s signingKeyService) RotateSigningKey() (string, error) {
+	if !s.config.JWT.UsesAsymmetricKeys() {
+		return "", domain.ErrSigningKeyRotationUnavailable
+	}
+
+	pair, err := s.factory.NewKeyPair(s.config.JWT.Algorithm)
+	if err != nil {
+		return "", domain.ErrSigningKeyCannotSave
+	}
+
+	now := time.Now()
+	activatesAt := now
+	if s.keySet.HasSigningKey() {
+		activatesAt = now.Add(keyPublicationDelay)
+	}
+
+	err = s.keys.Save(domain.SigningKey{
+		ID:          pair.ID,
+		Algorithm:   pair.Algorithm,
+		PrivateKey:  pair.PrivateKey,
+		PublicKey:   pair.PublicKey,
+		CreatedAt:   now,
+		ActivatesAt: activatesAt,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	verifyUntil := activatesAt.Add(keyPublicationDelay + s.longestTokenTTL())
+	if err := s.keys.RetireActive(pair.ID, activatesAt, verifyUntil); err != nil {
+		return "", err
+	}
+
+	if err := s.keySet.Reload(); err != nil {
+		return "", err
+	}
+
+	slog.Info("Rotated jwt signing key",
+		slog.String("kid", pair.ID),
+		slog.String("algorithm", pair.Algorithm),
+		slog.Time("activates_at", activatesAt))
+	return pair.ID, nil
+}
+
+func (s signingKeyService) EnsureSigningKey() error {
+	if !s.config.JWT.UsesAsymmetricKeys() || s.keySet.HasSigningKey() {
+		return nil
+	}
+
+	_, err := s.RotateSigningKey()
+	return err
+}
+
+func (s signingKeyService) PublicKeys() ([]token.JSONWebKey, error) {
+	if !s.config.JWT.UsesAsymmetricKeys() {
+		return []token.JSONWebKey{}, nil
+	}
+	return s.keySet.PublicKeys()
+}
+
+func (s signingKeyService) longestTokenTTL() time.Duration {
+	return max(s.config.JWT.AccessTokenTTL(), s.config.MFA.PendingTokenTTL())
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
+	"github.com/EstebanGitPro/motogo-backend/platform/jwt"
+)
+
+type memorySigningKeyRepository struct {
+	keys []domain.SigningKey
+}
+
+func (r *memorySigningKeyRepository) Save(key domain.SigningKey) error {
+	r.keys = append(r.keys, key)
+	return nil
+}
+
+func (r *memorySigningKeyRepository) ListVerifiable(at time.Time) ([]domain.SigningKey, error) {
+	var keys []domain.SigningKey
+	for _, key := range slices.Backward(r.keys) {
+		if key.VerifyUntil == nil || key.VerifyUntil.After(at) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (r *memorySigningKeyRepository) RetireActive(exceptID string, at, verifyUntil time.Time) error {
+	for i := range r.keys {
+		if r.keys[i].ID != exceptID && r.keys[i].RetiredAt == nil {
+			r.keys[i].RetiredAt = &at
+			r.keys[i].VerifyUntil = &verifyUntil
+		}
+	}
+	return nil
+}
+
+// signingKeyID reads the kid header of a signed token.
+func signingKeyID(t *testing.T, signed string) string {
+	t.Helper()
+
+	encoded, _, _ := strings.Cut(signed, ".")
+	header, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		t.Fatalf("decoding token header: %v", err)
+	}
+
+	var fields struct {
+		KeyID string `json:"kid"`
+	}
+	if err := json.Unmarshal(header, &fields); err != nil {
+		t.Fatalf("parsing token header: %v", err)
+	}
+	return fields.KeyID
+}
+
+func TestRotateSigningKeyPublishesBeforeSigning(t *testing.T) {
+	repo := &memorySigningKeyRepository{}
+	keyring, err := jwt.NewKeyring("motogo", nil, repo)
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	cfg := &config.Config{JWT: config.JWTConfig{Algorithm: token.AlgorithmEdDSA}}
+	service := NewSigningKeyService(repo, jwt.NewKeyFactory(), keyring, cfg)
+
+	if err := service.EnsureSigningKey(); err != nil {
+		t.Fatalf("EnsureSigningKey: %v", err)
+	}
+	if !keyring.HasSigningKey() {
+		t.Fatal("the first key must sign at once")
+	}
+	firstID := repo.keys[0].ID
+
+	secondID, err := service.RotateSigningKey()
+	if err != nil {
+		t.Fatalf("RotateSigningKey: %v", err)
+	}
+
+	published, err := service.PublicKeys()
+	if err != nil {
+		t.Fatalf("PublicKeys: %v", err)
+	}
+	ids := make([]string, 0, len(published))
+	for _, key := range published {
+		ids = append(ids, key.KeyID)
+	}
+	if !slices.Contains(ids, firstID) || !slices.Contains(ids, secondID) {
+		t.Errorf("published keys = %v, want both %s and %s", ids, firstID, secondID)
+	}
+
+	signed, err := keyring.Generate(token.Claims{ID: "person"}, time.Minute)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if kid := signingKeyID(t, signed); kid != firstID {
+		t.Errorf("signed with %s before the new key activated, want %s", kid, firstID)
+	}
+
+	// Once the publication delay has passed, the new key takes over.
+	activated := time.Now().Add(-time.Second)
+	repo.keys[0].RetiredAt = &activated
+	repo.keys[1].ActivatesAt = activated
+	if err := keyring.Reload(); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+
+	signed, err = keyring.Generate(token.Claims{ID: "person"}, time.Minute)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if kid := signingKeyID(t, signed); kid != secondID {
+		t.Errorf("signed with %s after activation, want %s", kid, secondID)
+	}
+}
+
+func TestRotateSigningKeyKeepsRetiredKeyVerifying(t *testing.T) {
+	repo := &memorySigningKeyRepository{}
+	keyring, err := jwt.NewKeyring("motogo", nil, repo)
+	if err != nil {
+		t.Fatalf("NewKeyring: %v", err)
+	}
+	cfg := &config.Config{JWT: config.JWTConfig{Algorithm: token.AlgorithmEdDSA}}
+	service := NewSigningKeyService(repo, jwt.NewKeyFactory(), keyring, cfg)
+
+	if err := service.EnsureSigningKey(); err != nil {
+		t.Fatalf("EnsureSigningKey: %v", err)
+	}
+	before := time.Now()
+	if _, err := service.RotateSigningKey(); err != nil {
+		t.Fatalf("RotateSigningKey: %v", err)
+	}
+
+	retired := repo.keys[0]
+	pending := repo.keys[1]
+	if pending.ActivatesAt.Before(before.Add(token.KeySetMaxAge)) {
+		t.Errorf("new key activates at %v, want at least the key set max age after the rotation", pending.ActivatesAt)
+	}
+	if retired.RetiredAt == nil || !retired.RetiredAt.Equal(pending.ActivatesAt) {
+		t.Errorf("old key retired at %v, want when the new key activates (%v)", retired.RetiredAt, pending.ActivatesAt)
+	}
+	minimum := pending.ActivatesAt.Add(token.KeyReloadInterval + token.KeySetMaxAge + cfg.JWT.AccessTokenTTL())
+	if retired.VerifyUntil == nil || retired.VerifyUntil.Before(minimum) {
+		t.Errorf("old key verifies until %v, want at least %v", retired.VerifyUntil, minimum)
+	}
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"errors"
+	"log/slog"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/mailer"
+)
+
+type verificationService struct {
+	repository ports.Repository
+	tokens     ports.VerificationTokenRepository
+	mailer     mailer.Mailer
+	throttle   requestThrottle
+	config     *config.Config
+}
+
+func NewVerificationService(repo ports.Repository, tokens ports.VerificationTokenRepository, m mailer.Mailer, attempts ports.LoginAttemptStore, cfg *config.Config) ports.VerificationService {
+	return &verificationService{
+		repository: repo,
+		tokens:     tokens,
+		mailer:     m,
+		throttle:   newRequestThrottle(attempts, cfg.Verification.ResendCooldown()),
+		config:     cfg,
+	}
+}
+
+func (s verificationService) SendEmailVerification(person domain.Person) error {
+	rawToken, err := issueVerificationToken(s.tokens, person.ID, domain.VerificationPurposeEmail, s.config.Verification.TokenTTL())
+	if err != nil {
+		return err
+	}
+
+	link, err := buildTokenLink(s.config.Verification.BaseURL, rawToken)
+	if err != nil {
+		return err
+	}
+
+	if err := s.mailer.Send(emailVerificationMessage(person, link)); err != nil {
+		slog.Error("Error sending verification email",
+			slog.String("person_id", person.ID),
+			slog.String("error", err.Error()))
+		return domain.ErrEmailCannotSend
+	}
+
+	return nil
+}
+
+func (s verificationService) VerifyEmail(token string) error {
+	stored, err := consumeVerificationToken(s.tokens, token, domain.VerificationPurposeEmail)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.SetEmailVerified(stored.PersonID)
+}
+
+// ResendEmailVerification allows one email per address within the resend
+// cooldown. The cooldown is keyed by the address and checked before the
+// lookup, so it does not tell whether an unverified account exists.
+func (s verificationService) ResendEmailVerification(email string) error {
+	if err := s.throttle.allow(map[string]int{domain.VerificationResendKey(email): 1}); err != nil {
+		return err
+	}
+
+	person, err := s.repository.GetPersonByEmail(email)
+	if err != nil {
+		if errors.Is(err, domain.ErrPersonNotFound) {
+			return nil
+		}
+		return domain.ErrUserCannotGet
+	}
+
+	if person.EmailVerified {
+		return nil
+	}
+
+	return s.SendEmailVerification(*person)
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/google/uuid"
+)
+
+// issueVerificationToken invalidates any pending token with the same purpose
+// and stores a new one. Only the hash is persisted; the raw token is returned
+// so it can be sent to the person.
+func issueVerificationToken(tokens ports.VerificationTokenRepository, personID, purpose string, ttl time.Duration) (string, error) {
+	if err := tokens.InvalidateForPerson(personID, purpose); err != nil {
+		return "", err
+	}
+
+	rawToken, tokenHash, err := newOpaqueToken()
+	if err != nil {
+		return "", domain.ErrTokenCannotCreate
+	}
+
+	now := time.Now()
+	err = tokens.Save(domain.VerificationToken{
+		ID:        uuid.New().String(),
+		PersonID:  personID,
+		Purpose:   purpose,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return rawToken, nil
+}
+
+func consumeVerificationToken(tokens ports.VerificationTokenRepository, token, purpose string) (*domain.VerificationToken, error) {
+	stored, err := lookupVerificationToken(tokens, token, purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := markVerificationTokenUsed(tokens, stored.ID); err != nil {
+		return nil, err
+	}
+
+	return stored, nil
+}
+
+// lookupVerificationToken checks that the token exists, has the purpose and
+// is still usable, without consuming it.
+func lookupVerificationToken(tokens ports.VerificationTokenRepository, token, purpose string) (*domain.VerificationToken, error) {
+	stored, err := tokens.GetByHash(hashOpaqueToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if stored.Purpose != purpose {
+		return nil, domain.ErrVerificationTokenNotFound
+	}
+
+	if stored.IsUsed() {
+		return nil, domain.ErrTokenAlreadyUsed
+	}
+
+	if stored.IsExpired(time.Now()) {
+		return nil, domain.ErrTokenExpired
+	}
+
+	return stored, nil
+}
+
+// markVerificationTokenUsed fails when another request consumed the token
+// first.
+func markVerificationTokenUsed(tokens ports.VerificationTokenRepository, id string) error {
+	consumed, err := tokens.MarkUsed(id, time.Now())
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		return domain.ErrTokenAlreadyUsed
+	}
+	return nil
+}
+
+func buildTokenLink(baseURL, token string) (string, error) {
+	link, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return link.String(), nil
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+func (h handler) CreateAPIKey() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		var createRequest CreateAPIKeyRequest
+		if err := c.ShouldBindJSON(&createRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		key, rawKey, err := h.APIKeyService.CreateAPIKey(createRequest.Name, createRequest.Scopes, createRequest.ExpiresAt, principal.ID)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, CreatedAPIKeyResponse{
+			APIKeyResponse: NewAPIKeyResponse(key),
+			Key:            rawKey,
+		})
+	}
+}
+
+func (h handler) ListAPIKeys() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		keys, err := h.APIKeyService.ListAPIKeys()
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		response := make([]APIKeyResponse, 0, len(keys))
+		for _, key := range keys {
+			response = append(response, NewAPIKeyResponse(key))
+		}
+
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+func (h handler) RevokeAPIKey() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		if err := h.APIKeyService.RevokeAPIKey(c.Param("id")); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type LoginRequest struct {
+	Email      string `json:"email"`
+	Password   string `json:"password"`
+	DeviceName string `json:"device_name"`
+	Platform   string `json:"platform"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type TokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int64  `json:"expires_in"`
+	RefreshToken     string `json:"refresh_token"`
+	RefreshExpiresIn int64  `json:"refresh_expires_in"`
+}
+
+func NewTokenResponse(tokens domain.AuthTokens) TokenResponse {
+	return TokenResponse{
+		AccessToken:      tokens.AccessToken,
+		TokenType:        tokens.TokenType,
+		ExpiresIn:        tokens.ExpiresIn,
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresIn: tokens.RefreshExpiresIn,
+	}
+}
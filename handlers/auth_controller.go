@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"net/http"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+func (h handler) Login() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		var loginRequest LoginRequest
+		if err := c.ShouldBindJSON(&loginRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		result, err := h.AuthService.Login(loginRequest.Email, loginRequest.Password, clientInfo(c, loginRequest.DeviceName, loginRequest.Platform))
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		if result.Tokens == nil {
+			c.JSON(http.StatusOK, NewMFAChallengeResponse(result))
+			return
+		}
+
+		c.JSON(http.StatusOK, NewTokenResponse(*result.Tokens))
+	}
+}
+
+func (h handler) RefreshToken() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		var refreshRequest RefreshTokenRequest
+		if err := c.ShouldBindJSON(&refreshRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		tokens, err := h.AuthService.Refresh(refreshRequest.RefreshToken, c.ClientIP())
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, NewTokenResponse(tokens))
+	}
+}
+
+func (h handler) Logout() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		var refreshRequest RefreshTokenRequest
+		if err := c.ShouldBindJSON(&refreshRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		if err := h.AuthService.Logout(refreshRequest.RefreshToken); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h handler) ClearLoginLockout() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		if err := h.AuthService.ClearLoginLockout(c.Query("email"), c.Query("ip")); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -2,7 +2,9 @@ package handlers
 
 import (
 	"errors"
+	"math"
 	"net/http"
+	"strconv"
 
 	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
 	"github.com/gin-gonic/gin"
@@ -22,6 +24,20 @@ type WebError struct {
 }
 
 func (h handler) HandleError(c *gin.Context, err error) {
+	var policyErr *domain.PasswordPolicyError
+	if errors.As(err, &policyErr) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   err.Error(),
+			"invalid": policyErr.Violations,
+		})
+		return
+	}
+
+	var retryErr *domain.RetryAfterError
+	if errors.As(err, &retryErr) {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryErr.RetryAfter.Seconds()))))
+	}
+
 	switch {
 	case errors.Is(err, ErrUnmarshalBody):
 		c.JSON(http.StatusBadRequest, WebError{
@@ -41,6 +57,12 @@ func (h handler) HandleError(c *gin.Context, err error) {
 			Message: err.Error(),
 		})
 		return
+	case errors.Is(err, domain.ErrInvalidJSONFormat):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
 	case errors.Is(err, ErrSchemaValidation):
 		c.JSON(http.StatusBadRequest, WebError{
 			Status:  http.StatusBadRequest,
@@ -53,6 +75,504 @@ func (h handler) HandleError(c *gin.Context, err error) {
 			Message: err.Error(),
 		})
 		return
+	case errors.Is(err, domain.ErrInvalidCredentials):
+		c.JSON(http.StatusUnauthorized, WebError{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidCurrentPassword):
+		c.JSON(http.StatusForbidden, WebError{
+			Status:  http.StatusForbidden,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidToken):
+		c.JSON(http.StatusUnauthorized, WebError{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrRefreshTokenReused):
+		c.JSON(http.StatusUnauthorized, WebError{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrRefreshTokenCannotSave):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrRefreshTokenCannotGet):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrVerificationTokenCannotSave):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrVerificationTokenCannotGet):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrEmailCannotSend):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrPersonNotFound):
+		c.JSON(http.StatusNotFound, WebError{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrPhoneNotVerified):
+		c.JSON(http.StatusForbidden, WebError{
+			Status:  http.StatusForbidden,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrPhoneAlreadyVerified):
+		c.JSON(http.StatusConflict, WebError{
+			Status:  http.StatusConflict,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrOTPNotFound):
+		c.JSON(http.StatusNotFound, WebError{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidOTP):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrOTPAttemptsExceeded):
+		c.JSON(http.StatusTooManyRequests, WebError{
+			Status:  http.StatusTooManyRequests,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrOTPResendCooldown):
+		c.JSON(http.StatusTooManyRequests, WebError{
+			Status:  http.StatusTooManyRequests,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrOTPCannotSave):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrOTPCannotGet):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrSMSCannotSend):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidRole):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrRoleNotAllowed):
+		c.JSON(http.StatusForbidden, WebError{
+			Status:  http.StatusForbidden,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrRoleOutranksCaller):
+		c.JSON(http.StatusForbidden, WebError{
+			Status:  http.StatusForbidden,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrStatusSetByHigher):
+		c.JSON(http.StatusForbidden, WebError{
+			Status:  http.StatusForbidden,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrTooManyLoginAttempts):
+		c.JSON(http.StatusTooManyRequests, WebError{
+			Status:  http.StatusTooManyRequests,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrTooManyEmailRequests):
+		c.JSON(http.StatusTooManyRequests, WebError{
+			Status:  http.StatusTooManyRequests,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAccountLocked):
+		c.JSON(http.StatusLocked, WebError{
+			Status:  http.StatusLocked,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrLockoutTargetRequired):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrLoginAttemptsCannotGet):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrLoginAttemptsCannotSave):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAccountSuspended):
+		c.JSON(http.StatusForbidden, WebError{
+			Status:  http.StatusForbidden,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAccountBanned):
+		c.JSON(http.StatusForbidden, WebError{
+			Status:  http.StatusForbidden,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidAccountStatus):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidStatusExpiry):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidDocumentType):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidIdentityNumber):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrDuplicateIdentityDocument):
+		c.JSON(http.StatusConflict, WebError{
+			Status:  http.StatusConflict,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidPhoneNumber):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrUnsupportedCountry):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrDuplicatePhoneNumber):
+		c.JSON(http.StatusConflict, WebError{
+			Status:  http.StatusConflict,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidPersonFilter):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidSortKey):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidCursor):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrOIDCProviderNotFound):
+		c.JSON(http.StatusNotFound, WebError{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrOIDCInvalidState):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrOIDCExchangeFailed):
+		c.JSON(http.StatusBadGateway, WebError{
+			Status:  http.StatusBadGateway,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrOIDCEmailNotVerified):
+		c.JSON(http.StatusForbidden, WebError{
+			Status:  http.StatusForbidden,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrOIDCAccountNotLinkable):
+		c.JSON(http.StatusConflict, WebError{
+			Status:  http.StatusConflict,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrExternalIdentityCannotSave):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrExternalIdentityCannotGet):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrVersionRequired):
+		c.JSON(http.StatusPreconditionRequired, WebError{
+			Status:  http.StatusPreconditionRequired,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrVersionConflict):
+		c.JSON(http.StatusPreconditionFailed, WebError{
+			Status:  http.StatusPreconditionFailed,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrPhotoRequired):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidPhoto):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrPhotoTooLarge):
+		c.JSON(http.StatusRequestEntityTooLarge, WebError{
+			Status:  http.StatusRequestEntityTooLarge,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrUnsupportedPhotoType):
+		c.JSON(http.StatusUnsupportedMediaType, WebError{
+			Status:  http.StatusUnsupportedMediaType,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrPhotoNotFound):
+		c.JSON(http.StatusNotFound, WebError{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrProfilePhotoRequired):
+		c.JSON(http.StatusForbidden, WebError{
+			Status:  http.StatusForbidden,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrPhotoCannotStore):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAccountDeletionNotFound):
+		c.JSON(http.StatusNotFound, WebError{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAccountDeletionAlreadyRequested):
+		c.JSON(http.StatusConflict, WebError{
+			Status:  http.StatusConflict,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAccountDeletionPending):
+		c.JSON(http.StatusConflict, WebError{
+			Status:  http.StatusConflict,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAccountDeletionCannotSave):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAccountDeletionCannotGet):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAPIKeyNotFound):
+		c.JSON(http.StatusNotFound, WebError{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidAPIKey):
+		c.JSON(http.StatusUnauthorized, WebError{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidScope):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAPIKeyExpiryInPast):
+		c.JSON(http.StatusBadRequest, WebError{
+			Status:  http.StatusBadRequest,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAPIKeyCannotSave):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrAPIKeyCannotGet):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrSigningKeyRotationUnavailable):
+		c.JSON(http.StatusConflict, WebError{
+			Status:  http.StatusConflict,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrSigningKeyCannotSave):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrSigningKeyCannotGet):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrSessionNotFound):
+		c.JSON(http.StatusNotFound, WebError{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrSessionRevoked):
+		c.JSON(http.StatusUnauthorized, WebError{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrSessionCannotSave):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrSessionCannotGet):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrMFAFactorNotFound):
+		c.JSON(http.StatusNotFound, WebError{
+			Status:  http.StatusNotFound,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrMFAAlreadyEnrolled):
+		c.JSON(http.StatusConflict, WebError{
+			Status:  http.StatusConflict,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrInvalidMFACode):
+		c.JSON(http.StatusUnauthorized, WebError{
+			Status:  http.StatusUnauthorized,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrMFANotAvailableForRole):
+		c.JSON(http.StatusForbidden, WebError{
+			Status:  http.StatusForbidden,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrMFARequiredByPolicy):
+		c.JSON(http.StatusForbidden, WebError{
+			Status:  http.StatusForbidden,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrMFACannotSave):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
+	case errors.Is(err, domain.ErrMFACannotGet):
+		c.JSON(http.StatusFailedDependency, WebError{
+			Status:  http.StatusFailedDependency,
+			Message: err.Error(),
+		})
+		return
 	case errors.Is(err, domain.ErrUserCannotSave):
 		c.JSON(http.StatusFailedDependency, WebError{
 			Status:  http.StatusFailedDependency,
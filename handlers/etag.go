@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// setPersonETag exposes the version of the person as a strong ETag. Clients
+// send it back in If-Match to update the person.
+func setPersonETag(c *gin.Context, person domain.Person) {
+	c.Header("ETag", `"`+strconv.FormatInt(person.Version, 10)+`"`)
+}
+
+// ifMatchVersion reads the version a client based its update on. Weak and
+// malformed tags can never match the current version.
+func ifMatchVersion(c *gin.Context) (int64, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" {
+		return 0, domain.ErrVersionRequired
+	}
+
+	tag, ok := strings.CutPrefix(header, `"`)
+	if !ok {
+		return 0, domain.ErrVersionConflict
+	}
+	tag, ok = strings.CutSuffix(tag, `"`)
+	if !ok {
+		return 0, domain.ErrVersionConflict
+	}
+
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil {
+		return 0, domain.ErrVersionConflict
+	}
+	return version, nil
+}
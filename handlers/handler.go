@@ -5,11 +5,33 @@ import (
 )
 
 type handler struct {
-	PersonService ports.Service
+	PersonService       ports.Service
+	AuthService         ports.AuthService
+	VerificationService ports.VerificationService
+	PhoneService        ports.PhoneVerificationService
+	PasswordService     ports.PasswordService
+	MFAService          ports.MFAService
+	SessionService      ports.SessionService
+	SigningKeyService   ports.SigningKeyService
+	APIKeyService       ports.APIKeyService
+	OIDCService         ports.OIDCService
+	PrivacyService      ports.PrivacyService
+	PhotoService        ports.PhotoService
 }
 
-func New(service ports.Service) *handler {
+func New(service ports.Service, authService ports.AuthService, verificationService ports.VerificationService, phoneService ports.PhoneVerificationService, passwordService ports.PasswordService, mfaService ports.MFAService, sessionService ports.SessionService, signingKeyService ports.SigningKeyService, apiKeyService ports.APIKeyService, oidcService ports.OIDCService, privacyService ports.PrivacyService, photoService ports.PhotoService) *handler {
 	return &handler{
-		PersonService: service,
+		PersonService:       service,
+		AuthService:         authService,
+		VerificationService: verificationService,
+		PhoneService:        phoneService,
+		PasswordService:     passwordService,
+		MFAService:          mfaService,
+		SessionService:      sessionService,
+		SigningKeyService:   signingKeyService,
+		APIKeyService:       apiKeyService,
+		OIDCService:         oidcService,
+		PrivacyService:      privacyService,
+		PhotoService:        photoService,
 	}
-}
\ No newline at end of file
+}
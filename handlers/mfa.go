@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type MFALoginRequest struct {
+	MFAToken   string `json:"mfa_token"`
+	Code       string `json:"code"`
+	DeviceName string `json:"device_name"`
+	Platform   string `json:"platform"`
+}
+
+type MFACodeRequest struct {
+	Code string `json:"code"`
+}
+
+type MFARolePolicyRequest struct {
+	Required bool `json:"required"`
+}
+
+// MFAChallengeResponse is returned by login instead of the tokens when the
+// account has to present or enroll a second factor.
+type MFAChallengeResponse struct {
+	MFARequired           bool   `json:"mfa_required"`
+	MFAEnrollmentRequired bool   `json:"mfa_enrollment_required"`
+	MFAToken              string `json:"mfa_token"`
+	ExpiresIn             int64  `json:"expires_in"`
+}
+
+type MFAEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string       `json:"recovery_codes"`
+	Tokens        *TokenResponse `json:"tokens,omitempty"`
+}
+
+func NewMFAChallengeResponse(result domain.LoginResult) MFAChallengeResponse {
+	return MFAChallengeResponse{
+		MFARequired:           result.MFARequired,
+		MFAEnrollmentRequired: result.MFAEnrollmentRequired,
+		MFAToken:              result.MFAToken,
+		ExpiresIn:             result.MFAExpiresIn,
+	}
+}
+
+func NewMFAEnrollmentResponse(enrollment domain.MFAEnrollment) MFAEnrollmentResponse {
+	return MFAEnrollmentResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+	}
+}
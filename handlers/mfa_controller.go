@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"net/http"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
+	"github.com/gin-gonic/gin"
+)
+
+func (h handler) CompleteMFALogin() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		var mfaRequest MFALoginRequest
+		if err := c.ShouldBindJSON(&mfaRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		tokens, err := h.AuthService.CompleteMFALogin(mfaRequest.MFAToken, mfaRequest.Code, clientInfo(c, mfaRequest.DeviceName, mfaRequest.Platform))
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, NewTokenResponse(tokens))
+	}
+}
+
+func (h handler) BeginTOTPEnrollment() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		enrollment, err := h.MFAService.BeginTOTPEnrollment(principal.ID)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, NewMFAEnrollmentResponse(enrollment))
+	}
+}
+
+// ConfirmTOTPEnrollment activates the factor. When the caller is finishing a
+// mandatory enrollment started from login, the response also carries the
+// session tokens so no second login is needed.
+func (h handler) ConfirmTOTPEnrollment() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		var codeRequest MFACodeRequest
+		if err := c.ShouldBindJSON(&codeRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		codes, err := h.MFAService.ConfirmTOTPEnrollment(principal.ID, codeRequest.Code)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		response := RecoveryCodesResponse{RecoveryCodes: codes}
+		if principal.Purpose == token.PurposeMFAEnrollment {
+			tokens, err := h.AuthService.IssueTokensFor(principal.ID, clientInfo(c, "", ""))
+			if err != nil {
+				h.HandleError(c, err)
+				return
+			}
+			tokenResponse := NewTokenResponse(tokens)
+			response.Tokens = &tokenResponse
+		}
+
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+func (h handler) DisableTOTP() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		var codeRequest MFACodeRequest
+		if err := c.ShouldBindJSON(&codeRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		if err := h.MFAService.DisableTOTP(principal.ID, codeRequest.Code); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h handler) ListMFARolePolicies() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		policies, err := h.MFAService.ListRolePolicies()
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, policies)
+	}
+}
+
+func (h handler) SetMFARolePolicy() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		var policyRequest MFARolePolicyRequest
+		if err := c.ShouldBindJSON(&policyRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		role := c.Param("role")
+		if err := h.MFAService.SetRolePolicy(role, policyRequest.Required); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, domain.MFARolePolicy{Role: role, Required: policyRequest.Required})
+	}
+}
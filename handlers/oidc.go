@@ -0,0 +1,5 @@
+package handlers
+
+type AuthorizationURLResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+}
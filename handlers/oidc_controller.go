@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+func (h handler) BeginOIDCLogin() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		authorizationURL, err := h.OIDCService.BeginLogin(c.Param("provider"))
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, AuthorizationURLResponse{AuthorizationURL: authorizationURL})
+	}
+}
+
+// CompleteOIDCLogin is the redirect target registered with the provider. It
+// answers like Login: tokens, or the second factor challenge.
+func (h handler) CompleteOIDCLogin() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		if c.Query("error") != "" {
+			h.HandleError(c, domain.ErrOIDCExchangeFailed)
+			return
+		}
+
+		code, state := c.Query("code"), c.Query("state")
+		if code == "" || state == "" {
+			h.HandleError(c, domain.ErrOIDCInvalidState)
+			return
+		}
+
+		result, err := h.OIDCService.CompleteLogin(c.Param("provider"), code, state, clientInfo(c, c.Query("device_name"), c.Query("platform")))
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		if result.Tokens == nil {
+			c.JSON(http.StatusOK, NewMFAChallengeResponse(result))
+			return
+		}
+
+		c.JSON(http.StatusOK, NewTokenResponse(*result.Tokens))
+	}
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+func (h handler) ForgotPassword() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		var forgotRequest ForgotPasswordRequest
+		if err := c.ShouldBindJSON(&forgotRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		if err := h.PasswordService.RequestPasswordReset(forgotRequest.Email, c.ClientIP()); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusAccepted, MessageResponse{Message: "if the account exists, a password reset email has been sent"})
+	}
+}
+
+func (h handler) ResetPassword() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		var resetRequest ResetPasswordRequest
+		if err := c.ShouldBindJSON(&resetRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		if err := h.PasswordService.ResetPassword(resetRequest.Token, resetRequest.Password); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessageResponse{Message: "password updated"})
+	}
+}
+
+func (h handler) ChangePassword() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		var changeRequest ChangePasswordRequest
+		if err := c.ShouldBindJSON(&changeRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		if err := h.PasswordService.ChangePassword(principal.ID, changeRequest.CurrentPassword, changeRequest.NewPassword); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessageResponse{Message: "password updated"})
+	}
+}
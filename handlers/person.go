@@ -1,48 +1,136 @@
 package handlers
 
 import (
+	"time"
+
 	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
 )
 
 type PersonRequest struct {
-	IdentityNumber      string `json:"identity_number"`
-	FirstName           string `json:"first_name"`
-	LastName            string `json:"last_name"`
-	SecondLastName      string `json:"second_last_name"`
-	Email               string `json:"email"`
-	PhoneNumber         string `json:"phone_number"`
-	Password            string `json:"password"`
-	EmailVerified       bool   `json:"email_verified"`
-	PhoneNumberVerified bool   `json:"phone_number_verified"`
-	Role                string `json:"role"`
+	DocumentType   string `json:"document_type"`
+	IdentityNumber string `json:"identity_number"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name"`
+	SecondLastName string `json:"second_last_name"`
+	Email          string `json:"email"`
+	PhoneNumber    string `json:"phone_number"`
+	Country        string `json:"country"`
+	Password       string `json:"password"`
+	Role           string `json:"role"`
 }
 
 type PersonResponse struct {
-	ID                  string `json:"id"`
-	IdentityNumber      string `json:"identity_number"`
-	FirstName           string `json:"first_name"`
-	LastName            string `json:"last_name"`
-	SecondLastName      string `json:"second_last_name"`
-	Email               string `json:"email"`
-	PhoneNumber         string `json:"phone_number"`
-	EmailVerified       bool   `json:"email_verified"`
-	PhoneNumberVerified bool   `json:"phone_number_verified"`
-	Role                string `json:"role"`
+	ID                  string     `json:"id"`
+	DocumentType        string     `json:"document_type"`
+	IdentityNumber      string     `json:"identity_number"`
+	FirstName           string     `json:"first_name"`
+	LastName            string     `json:"last_name"`
+	SecondLastName      string     `json:"second_last_name"`
+	Email               string     `json:"email"`
+	PhoneNumber         string     `json:"phone_number"`
+	Country             string     `json:"country"`
+	EmailVerified       bool       `json:"email_verified"`
+	PhoneNumberVerified bool       `json:"phone_number_verified"`
+	Role                string     `json:"role"`
+	Status              string     `json:"status"`
+	StatusReason        string     `json:"status_reason,omitempty"`
+	StatusExpiresAt     *time.Time `json:"status_expires_at,omitempty"`
+	PhotoURL            string     `json:"photo_url,omitempty"`
+	PhotoThumbnailURL   string     `json:"photo_thumbnail_url,omitempty"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	DeletedAt           *time.Time `json:"deleted_at,omitempty"`
 }
 
-
-
 func (p PersonRequest) ToDomain() domain.Person {
 	return domain.Person{
-		IdentityNumber:      p.IdentityNumber,
-		FirstName:           p.FirstName,
-		LastName:            p.LastName,
-		SecondLastName:      p.SecondLastName,
-		Email:               p.Email,
-		PhoneNumber:         p.PhoneNumber,
-		Password:            p.Password,
-		EmailVerified:       p.EmailVerified,
-		PhoneNumberVerified: p.PhoneNumberVerified,
-		Role:                p.Role,
+		DocumentType:   p.DocumentType,
+		IdentityNumber: p.IdentityNumber,
+		FirstName:      p.FirstName,
+		LastName:       p.LastName,
+		SecondLastName: p.SecondLastName,
+		Email:          p.Email,
+		PhoneNumber:    p.PhoneNumber,
+		Country:        p.Country,
+		Password:       p.Password,
+		Role:           p.Role,
+	}
+}
+
+type UpdatePersonRequest struct {
+	FirstName      *string `json:"first_name"`
+	LastName       *string `json:"last_name"`
+	SecondLastName *string `json:"second_last_name"`
+	Email          *string `json:"email"`
+	PhoneNumber    *string `json:"phone_number"`
+	Country        *string `json:"country"`
+}
+
+func (p UpdatePersonRequest) ToDomain() domain.PersonUpdate {
+	return domain.PersonUpdate{
+		FirstName:      p.FirstName,
+		LastName:       p.LastName,
+		SecondLastName: p.SecondLastName,
+		Email:          p.Email,
+		PhoneNumber:    p.PhoneNumber,
+		Country:        p.Country,
+	}
+}
+
+// PersonPageResponse is one page of a person listing. NextCursor is empty on
+// the last page.
+type PersonPageResponse struct {
+	Data       []PersonResponse `json:"data"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+}
+
+func NewPersonPageResponse(page domain.PersonPage) PersonPageResponse {
+	data := make([]PersonResponse, 0, len(page.Persons))
+	for _, person := range page.Persons {
+		data = append(data, NewPersonResponse(person))
+	}
+	return PersonPageResponse{Data: data, NextCursor: page.NextCursor}
+}
+
+type AccountStatusRequest struct {
+	Status    string     `json:"status"`
+	Reason    string     `json:"reason"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func (r AccountStatusRequest) ToDomain() domain.AccountStatusChange {
+	return domain.AccountStatusChange{
+		Status:    r.Status,
+		Reason:    r.Reason,
+		ExpiresAt: r.ExpiresAt,
 	}
-}
\ No newline at end of file
+}
+
+type AssignRoleRequest struct {
+	Role string `json:"role"`
+}
+
+func NewPersonResponse(person domain.Person) PersonResponse {
+	return PersonResponse{
+		ID:                  person.ID,
+		DocumentType:        person.DocumentType,
+		IdentityNumber:      person.IdentityNumber,
+		FirstName:           person.FirstName,
+		LastName:            person.LastName,
+		SecondLastName:      person.SecondLastName,
+		Email:               person.Email,
+		PhoneNumber:         person.PhoneNumber,
+		Country:             person.Country,
+		EmailVerified:       person.EmailVerified,
+		PhoneNumberVerified: person.PhoneNumberVerified,
+		Role:                person.Role,
+		Status:              person.Status,
+		StatusReason:        person.StatusReason,
+		StatusExpiresAt:     person.StatusExpiresAt,
+		PhotoURL:            person.PhotoURL,
+		PhotoThumbnailURL:   person.PhotoThumbnailURL,
+		CreatedAt:           person.CreatedAt,
+		UpdatedAt:           person.UpdatedAt,
+		DeletedAt:           person.DeletedAt,
+	}
+}
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
 	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
 	"github.com/gin-gonic/gin"
@@ -17,7 +19,8 @@ func (h handler) GetPersonByEmail() func(c *gin.Context) {
 			return
 		}
 
-		c.JSON(http.StatusOK, person)
+		setPersonETag(c, *person)
+		c.JSON(http.StatusOK, NewPersonResponse(*person))
 	}
 }
 
@@ -32,10 +35,19 @@ func (h handler) RegisterPerson() func(c *gin.Context) {
 
 		person, err := h.PersonService.RegisterPerson(personRequest.ToDomain())
 		if err != nil {
+			if errors.Is(err, domain.ErrWeakPassword) {
+				h.HandleError(c, err)
+				return
+			}
 
 			switch err {
 			case domain.ErrDuplicateUser:
 				h.HandleError(c, domain.ErrDuplicateUser)
+			case domain.ErrDuplicateIdentityDocument, domain.ErrInvalidDocumentType, domain.ErrInvalidIdentityNumber,
+				domain.ErrDuplicatePhoneNumber, domain.ErrInvalidPhoneNumber, domain.ErrUnsupportedCountry:
+				h.HandleError(c, err)
+			case domain.ErrRoleNotAllowed:
+				h.HandleError(c, domain.ErrRoleNotAllowed)
 			case domain.ErrUserCannotSave:
 				h.HandleError(c, domain.ErrUserCannotSave)
 			default:
@@ -44,19 +56,217 @@ func (h handler) RegisterPerson() func(c *gin.Context) {
 			return
 		}
 
-		response := PersonResponse{
-			ID:                  person.ID,
-			IdentityNumber:      person.IdentityNumber,
-			FirstName:           person.FirstName,
-			LastName:            person.LastName,
-			SecondLastName:      person.SecondLastName,
-			Email:               person.Email,
-			PhoneNumber:         person.PhoneNumber,
-			EmailVerified:       person.EmailVerified,
-			PhoneNumberVerified: person.PhoneNumberVerified,
-			Role:                person.Role,
+		c.JSON(http.StatusCreated, NewPersonResponse(person))
+	}
+}
+
+func (h handler) AssignRole() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		id := c.Param("id")
+
+		var assignRoleRequest AssignRoleRequest
+		if err := c.ShouldBindJSON(&assignRoleRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		person, err := h.PersonService.AssignRole(id, assignRoleRequest.Role, version)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		setPersonETag(c, *person)
+		c.JSON(http.StatusOK, NewPersonResponse(*person))
+	}
+}
+
+func (h handler) GetPersonByID() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		person, err := h.PersonService.GetPersonByID(c.Param("id"))
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		setPersonETag(c, *person)
+		c.JSON(http.StatusOK, NewPersonResponse(*person))
+	}
+}
+
+func (h handler) GetCurrentPerson() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		person, err := h.PersonService.GetPersonByID(principal.ID)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		setPersonETag(c, *person)
+		c.JSON(http.StatusOK, NewPersonResponse(*person))
+	}
+}
+
+func (h handler) UpdateCurrentPerson() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		var updateRequest UpdatePersonRequest
+		if err := c.ShouldBindJSON(&updateRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		person, err := h.PersonService.UpdatePerson(principal.ID, updateRequest.ToDomain(), version)
+		if err != nil {
+			h.HandleError(c, err)
+			return
 		}
 
-		c.JSON(http.StatusCreated, response)
+		setPersonETag(c, *person)
+		c.JSON(http.StatusOK, NewPersonResponse(*person))
 	}
-}
\ No newline at end of file
+}
+
+func (h handler) ListPersons() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		filter, err := personFilterFromQuery(c)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		limit := 0
+		if value := c.Query("limit"); value != "" {
+			limit, err = strconv.Atoi(value)
+			if err != nil || limit <= 0 {
+				h.HandleError(c, domain.ErrInvalidPersonFilter)
+				return
+			}
+		}
+
+		page, err := h.PersonService.ListPersons(filter, c.Query("sort"), c.Query("cursor"), limit)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, NewPersonPageResponse(page))
+	}
+}
+
+func personFilterFromQuery(c *gin.Context) (domain.PersonFilter, error) {
+	filter := domain.PersonFilter{
+		Role:           c.Query("role"),
+		Status:         c.Query("status"),
+		NamePrefix:     c.Query("name"),
+		DocumentType:   c.Query("document_type"),
+		IdentityNumber: c.Query("identity_number"),
+		PhoneNumber:    c.Query("phone_number"),
+	}
+
+	includeDeleted, err := optionalBoolQuery(c, "include_deleted")
+	if err != nil {
+		return domain.PersonFilter{}, err
+	}
+	filter.IncludeDeleted = includeDeleted != nil && *includeDeleted
+
+	if filter.Role != "" && !domain.IsValidRole(filter.Role) {
+		return domain.PersonFilter{}, domain.ErrInvalidRole
+	}
+	if filter.Status != "" && !domain.IsValidAccountStatus(filter.Status) {
+		return domain.PersonFilter{}, domain.ErrInvalidAccountStatus
+	}
+	if filter.DocumentType != "" {
+		if !domain.IsValidDocumentType(filter.DocumentType) {
+			return domain.PersonFilter{}, domain.ErrInvalidDocumentType
+		}
+		filter.IdentityNumber = domain.NormalizeIdentityNumber(filter.DocumentType, filter.IdentityNumber)
+	}
+	if filter.PhoneNumber != "" {
+		phone, err := domain.NormalizePhoneNumber(filter.PhoneNumber, c.Query("country"))
+		if err != nil {
+			return domain.PersonFilter{}, err
+		}
+		filter.PhoneNumber = phone.E164
+	}
+
+	if filter.EmailVerified, err = optionalBoolQuery(c, "email_verified"); err != nil {
+		return domain.PersonFilter{}, err
+	}
+	if filter.PhoneNumberVerified, err = optionalBoolQuery(c, "phone_number_verified"); err != nil {
+		return domain.PersonFilter{}, err
+	}
+
+	return filter, nil
+}
+
+func optionalBoolQuery(c *gin.Context, name string) (*bool, error) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, domain.ErrInvalidPersonFilter
+	}
+	return &parsed, nil
+}
+
+func (h handler) SetAccountStatus() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		var statusRequest AccountStatusRequest
+		if err := c.ShouldBindJSON(&statusRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		person, err := h.PersonService.SetAccountStatus(principal.Role, c.Param("id"), statusRequest.ToDomain(), version)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		setPersonETag(c, *person)
+		c.JSON(http.StatusOK, NewPersonResponse(*person))
+	}
+}
@@ -0,0 +1,5 @@
+package handlers
+
+type ConfirmPhoneOTPRequest struct {
+	Code string `json:"code"`
+}
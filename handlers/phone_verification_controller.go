@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+func (h handler) RequestPhoneOTP() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		if err := h.PhoneService.RequestPhoneOTP(principal.ID); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusAccepted, MessageResponse{Message: "verification code sent"})
+	}
+}
+
+func (h handler) ConfirmPhoneOTP() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		var confirmRequest ConfirmPhoneOTPRequest
+		if err := c.ShouldBindJSON(&confirmRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		if err := h.PhoneService.ConfirmPhoneOTP(principal.ID, confirmRequest.Code); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessageResponse{Message: "phone number verified"})
+	}
+}
@@ -0,0 +1,15 @@
+package handlers
+
+import domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+
+type PhotoResponse struct {
+	PhotoURL          string `json:"photo_url"`
+	PhotoThumbnailURL string `json:"photo_thumbnail_url"`
+}
+
+func NewPhotoResponse(person domain.Person) PhotoResponse {
+	return PhotoResponse{
+		PhotoURL:          person.PhotoURL,
+		PhotoThumbnailURL: person.PhotoThumbnailURL,
+	}
+}
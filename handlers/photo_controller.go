@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// photoFormField is the multipart field that carries the uploaded photo.
+const photoFormField = "photo"
+
+func (h handler) UploadCurrentPersonPhoto() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		data, err := readPhoto(c)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		person, err := h.PhotoService.UploadProfilePhoto(principal.ID, data, version)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		setPersonETag(c, *person)
+		c.JSON(http.StatusOK, NewPhotoResponse(*person))
+	}
+}
+
+func (h handler) DeleteCurrentPersonPhoto() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		person, err := h.PhotoService.DeleteProfilePhoto(principal.ID, version)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		setPersonETag(c, *person)
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// DeletePersonPhoto lets staff remove a photo that breaks the content rules.
+func (h handler) DeletePersonPhoto() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		person, err := h.PhotoService.DeleteProfilePhoto(c.Param("id"), version)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		setPersonETag(c, *person)
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// readPhoto reads the uploaded file. The declared content type is checked
+// here; the service checks the bytes themselves, since clients can declare
+// anything.
+func readPhoto(c *gin.Context) ([]byte, error) {
+	file, err := c.FormFile(photoFormField)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, domain.ErrPhotoTooLarge
+		}
+		return nil, domain.ErrPhotoRequired
+	}
+
+	if declared := file.Header.Get("Content-Type"); declared != "" {
+		mediaType, _, err := mime.ParseMediaType(declared)
+		if err != nil || !domain.IsSupportedPhotoType(mediaType) {
+			return nil, domain.ErrUnsupportedPhotoType
+		}
+	}
+
+	content, err := file.Open()
+	if err != nil {
+		return nil, domain.ErrInvalidPhoto
+	}
+	defer content.Close()
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, domain.ErrInvalidPhoto
+	}
+	return data, nil
+}
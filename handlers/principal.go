@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// currentPrincipal returns the caller stored by the auth middleware. Handlers
+// on protected routes can rely on it being present.
+func currentPrincipal(c *gin.Context) (*domain.Principal, bool) {
+	return middleware.GetPrincipal(c)
+}
+
+func clientInfo(c *gin.Context, deviceName, platform string) domain.ClientInfo {
+	return domain.ClientInfo{
+		IP:         c.ClientIP(),
+		UserAgent:  c.Request.UserAgent(),
+		DeviceName: deviceName,
+		Platform:   platform,
+	}
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type AccountDeletionResponse struct {
+	RequestedAt  time.Time `json:"requested_at"`
+	ScheduledFor time.Time `json:"scheduled_for"`
+}
+
+type ExternalIdentityResponse struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MFAStatusResponse struct {
+	Enrolled    bool       `json:"enrolled"`
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+}
+
+// DataExportResponse is the bundle returned for a Habeas Data access request.
+type DataExportResponse struct {
+	GeneratedAt        time.Time                  `json:"generated_at"`
+	Person             PersonResponse             `json:"person"`
+	Sessions           []SessionResponse          `json:"sessions"`
+	ExternalIdentities []ExternalIdentityResponse `json:"external_identities"`
+	MFA                MFAStatusResponse          `json:"mfa"`
+	Deletion           *AccountDeletionResponse   `json:"deletion,omitempty"`
+}
+
+func NewAccountDeletionResponse(deletion domain.AccountDeletion) AccountDeletionResponse {
+	return AccountDeletionResponse{
+		RequestedAt:  deletion.RequestedAt,
+		ScheduledFor: deletion.ScheduledFor,
+	}
+}
+
+func NewDataExportResponse(export domain.PersonDataExport, currentSessionID string) DataExportResponse {
+	sessions := make([]SessionResponse, 0, len(export.Sessions))
+	for _, session := range export.Sessions {
+		sessions = append(sessions, NewSessionResponse(session, currentSessionID))
+	}
+
+	identities := make([]ExternalIdentityResponse, 0, len(export.ExternalIdentities))
+	for _, identity := range export.ExternalIdentities {
+		identities = append(identities, ExternalIdentityResponse{
+			Provider:  identity.Provider,
+			Subject:   identity.Subject,
+			Email:     identity.Email,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+
+	response := DataExportResponse{
+		GeneratedAt:        export.GeneratedAt,
+		Person:             NewPersonResponse(export.Person),
+		Sessions:           sessions,
+		ExternalIdentities: identities,
+		MFA: MFAStatusResponse{
+			Enrolled:    export.MFA.Enrolled,
+			ConfirmedAt: export.MFA.ConfirmedAt,
+		},
+	}
+	if export.Deletion != nil {
+		deletion := NewAccountDeletionResponse(*export.Deletion)
+		response.Deletion = &deletion
+	}
+
+	return response
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+func (h handler) ExportPersonData() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		export, err := h.PrivacyService.ExportPersonData(principal.ID)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.Header("Content-Disposition", `attachment; filename="motogo-data-export.json"`)
+		c.Header("Cache-Control", "no-store")
+		c.JSON(http.StatusOK, NewDataExportResponse(export, principal.SessionID))
+	}
+}
+
+func (h handler) RequestAccountDeletion() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		deletion, err := h.PrivacyService.RequestAccountDeletion(principal.ID)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusAccepted, NewAccountDeletionResponse(deletion))
+	}
+}
+
+func (h handler) GetAccountDeletion() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		deletion, err := h.PrivacyService.GetAccountDeletion(principal.ID)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+		if deletion == nil {
+			h.HandleError(c, domain.ErrAccountDeletionNotFound)
+			return
+		}
+
+		c.JSON(http.StatusOK, NewAccountDeletionResponse(*deletion))
+	}
+}
+
+func (h handler) CancelAccountDeletion() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		if err := h.PrivacyService.CancelAccountDeletion(principal.ID); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"net/http"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+func (h handler) ListSessions() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		sessions, err := h.SessionService.ListSessions(principal.ID)
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		response := make([]SessionResponse, 0, len(sessions))
+		for _, session := range sessions {
+			response = append(response, NewSessionResponse(session, principal.SessionID))
+		}
+
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+func (h handler) RevokeSession() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		principal, ok := currentPrincipal(c)
+		if !ok {
+			h.HandleError(c, domain.ErrInvalidToken)
+			return
+		}
+
+		if err := h.SessionService.RevokeSession(principal.ID, c.Param("id")); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h handler) RevokePersonSessions() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		if err := h.SessionService.RevokeAllSessions(c.Param("id")); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,13 @@
+package handlers
+
+import (
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
+)
+
+type JWKSResponse struct {
+	Keys []token.JSONWebKey `json:"keys"`
+}
+
+type SigningKeyResponse struct {
+	KeyID string `json:"kid"`
+}
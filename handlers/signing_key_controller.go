@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
+	"github.com/gin-gonic/gin"
+)
+
+func (h handler) JWKS() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		keys, err := h.SigningKeyService.PublicKeys()
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(token.KeySetMaxAge.Seconds())))
+		c.JSON(http.StatusOK, JWKSResponse{Keys: keys})
+	}
+}
+
+func (h handler) RotateSigningKey() func(c *gin.Context) {
+	return func(c *gin.Context) {
+		kid, err := h.SigningKeyService.RotateSigningKey()
+		if err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusCreated, SigningKeyResponse{KeyID: kid})
+	}
+}
@@ -0,0 +1,13 @@
+package handlers
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+type ResendVerificationRequest struct {
+	Email string `json:"email"`
+}
+
+type MessageResponse struct {
+	Message string `json:"message"`
+}
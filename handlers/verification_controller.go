@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"net/http"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/gin-gonic/gin"
+)
+
+func (h handler) VerifyEmail() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		token := c.Query("token")
+		if c.Request.Method == http.MethodPost {
+			var verifyRequest VerifyEmailRequest
+			if err := c.ShouldBindJSON(&verifyRequest); err != nil {
+				h.HandleError(c, domain.ErrInvalidJSONFormat)
+				return
+			}
+			token = verifyRequest.Token
+		}
+
+		if token == "" {
+			h.HandleError(c, domain.ErrVerificationTokenNotFound)
+			return
+		}
+
+		if err := h.VerificationService.VerifyEmail(token); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessageResponse{Message: "email verified"})
+	}
+}
+
+func (h handler) ResendEmailVerification() func(c *gin.Context) {
+	return func(c *gin.Context) {
+
+		var resendRequest ResendVerificationRequest
+		if err := c.ShouldBindJSON(&resendRequest); err != nil {
+			h.HandleError(c, domain.ErrInvalidJSONFormat)
+			return
+		}
+
+		if err := h.VerificationService.ResendEmailVerification(resendRequest.Email); err != nil {
+			h.HandleError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusAccepted, MessageResponse{Message: "if the account exists and is not verified, a verification email has been sent"})
+	}
+}
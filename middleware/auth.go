@@ -0,0 +1,256 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	principalContextKey = "principal"
+	bearerPrefix        = "Bearer "
+	apiKeyHeader        = "X-API-Key"
+)
+
+// Policy describes who may call a protected route. The zero value allows
+// nobody, so a route registered without an explicit policy is denied.
+// Only access tokens are accepted unless Purposes lists others, and API keys
+// are only accepted when the policy lists the Scopes they need.
+type Policy struct {
+	Roles            []string
+	AnyAuthenticated bool
+	Purposes         []string
+	Scopes           []string
+}
+
+func Roles(roles ...string) Policy {
+	return Policy{Roles: roles}
+}
+
+func AnyAuthenticated() Policy {
+	return Policy{AnyAuthenticated: true}
+}
+
+// WithPurposes returns a copy of the policy that also accepts tokens issued
+// for the given purposes, such as a pending 2FA enrollment.
+func (p Policy) WithPurposes(purposes ...string) Policy {
+	p.Purposes = append([]string{token.PurposeAccess}, purposes...)
+	return p
+}
+
+// WithScopes returns a copy of the policy that also admits API keys holding
+// every one of the given scopes.
+func (p Policy) WithScopes(scopes ...string) Policy {
+	p.Scopes = scopes
+	return p
+}
+
+func (p Policy) allows(principal *domain.Principal) bool {
+	if principal.IsAPIKey() {
+		return len(p.Scopes) > 0 && principal.HasScopes(p.Scopes...)
+	}
+
+	purposes := p.Purposes
+	if len(purposes) == 0 {
+		purposes = []string{token.PurposeAccess}
+	}
+	if !slices.Contains(purposes, principal.Purpose) {
+		return false
+	}
+
+	if p.AnyAuthenticated {
+		return true
+	}
+	return slices.Contains(p.Roles, principal.Role)
+}
+
+type AuthMiddleware struct {
+	generator token.Generator
+	sessions  ports.SessionService
+	apiKeys   ports.APIKeyService
+	persons   ports.Service
+	policies  map[string]Policy
+}
+
+func NewAuthMiddleware(generator token.Generator, sessions ports.SessionService, apiKeys ports.APIKeyService, persons ports.Service) *AuthMiddleware {
+	return &AuthMiddleware{
+		generator: generator,
+		sessions:  sessions,
+		apiKeys:   apiKeys,
+		persons:   persons,
+		policies:  make(map[string]Policy),
+	}
+}
+
+// Authenticate accepts an X-API-Key header or a bearer token and stores the
+// resulting principal in the request context. Access tokens are only
+// accepted while their session is active, so revoking a session takes effect
+// on the next request, and only while the account is not suspended or
+// banned.
+func (a *AuthMiddleware) Authenticate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if rawKey := c.GetHeader(apiKeyHeader); rawKey != "" {
+			principal, err := a.apiKeys.Authenticate(rawKey)
+			if err != nil {
+				if errors.Is(err, domain.ErrInvalidAPIKey) {
+					ValidateError(c, err, nil, http.StatusUnauthorized)
+					return
+				}
+				ValidateError(c, ErrInternalServer, nil, http.StatusInternalServerError)
+				return
+			}
+
+			c.Set(principalContextKey, principal)
+			c.Next()
+			return
+		}
+
+		header := c.GetHeader("Authorization")
+		if !strings.HasPrefix(header, bearerPrefix) {
+			ValidateError(c, ErrMissingBearerToken, nil, http.StatusUnauthorized)
+			return
+		}
+
+		claims, err := a.generator.Validate(strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix)))
+		if err != nil {
+			ValidateError(c, ErrInvalidBearerToken, nil, http.StatusUnauthorized)
+			return
+		}
+
+		if claims.Purpose == token.PurposeAccess {
+			if claims.SessionID == "" {
+				ValidateError(c, ErrInvalidBearerToken, nil, http.StatusUnauthorized)
+				return
+			}
+			if err := a.sessions.CheckSession(claims.ID, claims.SessionID, c.ClientIP()); err != nil {
+				if errors.Is(err, domain.ErrSessionRevoked) {
+					ValidateError(c, err, nil, http.StatusUnauthorized)
+					return
+				}
+				ValidateError(c, ErrInternalServer, nil, http.StatusInternalServerError)
+				return
+			}
+		}
+
+		// The role comes from the stored person rather than the token, so a
+		// role change applies to tokens that were already issued.
+		person, err := a.persons.CheckAccountStatus(claims.ID)
+		if err != nil {
+			switch {
+			case errors.Is(err, domain.ErrAccountSuspended), errors.Is(err, domain.ErrAccountBanned):
+				ValidateError(c, err, nil, http.StatusForbidden)
+			case errors.Is(err, domain.ErrPersonNotFound):
+				ValidateError(c, ErrInvalidBearerToken, nil, http.StatusUnauthorized)
+			default:
+				ValidateError(c, ErrInternalServer, nil, http.StatusInternalServerError)
+			}
+			return
+		}
+
+		c.Set(principalContextKey, &domain.Principal{
+			Type:      domain.PrincipalPerson,
+			ID:        claims.ID,
+			Email:     claims.Email,
+			Role:      person.Role,
+			Purpose:   claims.Purpose,
+			SessionID: claims.SessionID,
+		})
+		c.Next()
+	}
+}
+
+func (a *AuthMiddleware) authorize() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := GetPrincipal(c)
+		if !ok {
+			ValidateError(c, ErrMissingBearerToken, nil, http.StatusUnauthorized)
+			return
+		}
+
+		policy, registered := a.policies[policyKey(c.Request.Method, c.FullPath())]
+		if !registered || !policy.allows(principal) {
+			ValidateError(c, ErrForbidden, nil, http.StatusForbidden)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// Protect attaches authentication and authorization to the group. Routes must
+// be registered through the returned ProtectedGroup so they carry a policy;
+// anything added to the underlying gin group directly is denied.
+func (a *AuthMiddleware) Protect(group *gin.RouterGroup) *ProtectedGroup {
+	group.Use(a.Authenticate(), a.authorize())
+	return &ProtectedGroup{
+		group: group,
+		auth:  a,
+	}
+}
+
+type ProtectedGroup struct {
+	group *gin.RouterGroup
+	auth  *AuthMiddleware
+}
+
+func (p *ProtectedGroup) Group(relativePath string) *ProtectedGroup {
+	return &ProtectedGroup{
+		group: p.group.Group(relativePath),
+		auth:  p.auth,
+	}
+}
+
+func (p *ProtectedGroup) Use(middlewares ...gin.HandlerFunc) {
+	p.group.Use(middlewares...)
+}
+
+func (p *ProtectedGroup) Handle(method, relativePath string, policy Policy, handlers ...gin.HandlerFunc) {
+	p.auth.policies[policyKey(method, joinPaths(p.group.BasePath(), relativePath))] = policy
+	p.group.Handle(method, relativePath, handlers...)
+}
+
+func (p *ProtectedGroup) GET(relativePath string, policy Policy, handlers ...gin.HandlerFunc) {
+	p.Handle(http.MethodGet, relativePath, policy, handlers...)
+}
+
+func (p *ProtectedGroup) POST(relativePath string, policy Policy, handlers ...gin.HandlerFunc) {
+	p.Handle(http.MethodPost, relativePath, policy, handlers...)
+}
+
+func (p *ProtectedGroup) PUT(relativePath string, policy Policy, handlers ...gin.HandlerFunc) {
+	p.Handle(http.MethodPut, relativePath, policy, handlers...)
+}
+
+func (p *ProtectedGroup) PATCH(relativePath string, policy Policy, handlers ...gin.HandlerFunc) {
+	p.Handle(http.MethodPatch, relativePath, policy, handlers...)
+}
+
+func (p *ProtectedGroup) DELETE(relativePath string, policy Policy, handlers ...gin.HandlerFunc) {
+	p.Handle(http.MethodDelete, relativePath, policy, handlers...)
+}
+
+func GetPrincipal(c *gin.Context) (*domain.Principal, bool) {
+	value, exists := c.Get(principalContextKey)
+	if !exists {
+		return nil, false
+	}
+	principal, ok := value.(*domain.Principal)
+	return principal, ok
+}
+
+func policyKey(method, fullPath string) string {
+	return method + " " + fullPath
+}
+
+func joinPaths(basePath, relativePath string) string {
+	if relativePath == "" {
+		return basePath
+	}
+	return strings.TrimSuffix(basePath, "/") + "/" + strings.TrimPrefix(relativePath, "/")
+}
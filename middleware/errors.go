@@ -21,6 +21,9 @@ var (
 	ErrValidationUserFailed    = errors.New("user validation failed")
 	ErrValidationUserNotFound  = errors.New("user not found")
 	ErrValidationUserAlreadyExists = errors.New("user already exists")
+	ErrMissingBearerToken      = errors.New("missing bearer token")
+	ErrInvalidBearerToken      = errors.New("invalid or expired token")
+	ErrForbidden               = errors.New("insufficient permissions")
 )
 
 
@@ -31,6 +31,62 @@ func (b *Builder) WithValidateRegister() gin.HandlerFunc {
 	return b.jsonValidator(b.Validators.RegisterValidator )
 }
 
+func (b *Builder) WithValidateLogin() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.LoginValidator)
+}
+
+func (b *Builder) WithValidateRefreshToken() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.RefreshValidator)
+}
+
+func (b *Builder) WithValidateVerifyEmail() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.VerifyEmailValidator)
+}
+
+func (b *Builder) WithValidateEmail() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.EmailValidator)
+}
+
+func (b *Builder) WithValidatePhoneOTP() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.PhoneOTPValidator)
+}
+
+func (b *Builder) WithValidateAssignRole() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.AssignRoleValidator)
+}
+
+func (b *Builder) WithValidateResetPassword() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.ResetPasswordValidator)
+}
+
+func (b *Builder) WithValidateChangePassword() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.ChangePasswordValidator)
+}
+
+func (b *Builder) WithValidateMFALogin() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.MFALoginValidator)
+}
+
+func (b *Builder) WithValidateMFACode() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.MFACodeValidator)
+}
+
+func (b *Builder) WithValidateMFARolePolicy() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.MFARolePolicyValidator)
+}
+
+func (b *Builder) WithValidateCreateAPIKey() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.CreateAPIKeyValidator)
+}
+
+func (b *Builder) WithValidateUpdatePerson() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.UpdatePersonValidator)
+}
+
+func (b *Builder) WithValidateAccountStatus() gin.HandlerFunc {
+	return b.jsonValidator(b.Validators.AccountStatusValidator)
+}
+
 
 func (b *Builder) jsonValidator(schema *jsonschema.Schema) gin.HandlerFunc {
     return func(c *gin.Context) {
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireVerifiedPhone blocks callers with one of the given roles until they
+// have confirmed their phone number. It must run after Authenticate.
+func RequireVerifiedPhone(phoneService ports.PhoneVerificationService, roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := GetPrincipal(c)
+		if !ok {
+			ValidateError(c, ErrMissingBearerToken, nil, http.StatusUnauthorized)
+			return
+		}
+
+		if !slices.Contains(roles, principal.Role) {
+			c.Next()
+			return
+		}
+
+		if err := phoneService.EnsurePhoneVerified(principal.ID); err != nil {
+			if errors.Is(err, domain.ErrPhoneNotVerified) {
+				ValidateError(c, err, nil, http.StatusForbidden)
+				return
+			}
+			ValidateError(c, ErrInternalServer, nil, http.StatusInternalServerError)
+			return
+		}
+
+		c.Next()
+	}
+}
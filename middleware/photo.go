@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/gin-gonic/gin"
+)
+
+// multipartOverhead is the room left in an upload request for the multipart
+// boundaries and part headers around the file.
+const multipartOverhead = 64 << 10
+
+// LimitUploadSize caps the request body of an upload of at most maxFileBytes.
+// Reading past the limit fails, so handlers never buffer oversized uploads.
+func LimitUploadSize(maxFileBytes int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.ContentLength > maxFileBytes+multipartOverhead {
+			ValidateError(c, domain.ErrPhotoTooLarge, nil, http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxFileBytes+multipartOverhead)
+		c.Next()
+	}
+}
+
+// RequireProfilePhoto blocks callers with one of the given roles until they
+// have uploaded a profile photo. It must run after Authenticate.
+func RequireProfilePhoto(persons ports.Service, roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := GetPrincipal(c)
+		if !ok {
+			ValidateError(c, ErrMissingBearerToken, nil, http.StatusUnauthorized)
+			return
+		}
+
+		if !slices.Contains(roles, principal.Role) {
+			c.Next()
+			return
+		}
+
+		person, err := persons.GetPersonByID(principal.ID)
+		if err != nil {
+			if errors.Is(err, domain.ErrPersonNotFound) {
+				ValidateError(c, ErrInvalidBearerToken, nil, http.StatusUnauthorized)
+				return
+			}
+			ValidateError(c, ErrInternalServer, nil, http.StatusInternalServerError)
+			return
+		}
+
+		if !person.HasPhoto() {
+			ValidateError(c, domain.ErrProfilePhotoRequired, nil, http.StatusForbidden)
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,48 @@
+package hasher
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/EstebanGitPro/motogo-backend/core/ports/password"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrUnknownHashScheme = errors.New("unknown password hash scheme")
+
+// hasher produces argon2id hashes and still verifies the bcrypt hashes stored
+// before argon2id became the default. The scheme is read from the hash prefix.
+type hasher struct {
+	argon2id argon2idHasher
+}
+
+func NewHasher(params Argon2idParams) password.Hasher {
+	return &hasher{
+		argon2id: argon2idHasher{params: params},
+	}
+}
+
+func (h *hasher) Hash(plain string) (string, error) {
+	return h.argon2id.hash(plain)
+}
+
+func (h *hasher) Verify(hash, plain string) (bool, bool, error) {
+	switch {
+	case strings.HasPrefix(hash, argon2idPrefix):
+		return h.argon2id.verify(hash, plain)
+	case isBcrypt(hash):
+		if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(plain)); err != nil {
+			if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+				return false, false, nil
+			}
+			return false, false, err
+		}
+		return true, true, nil
+	default:
+		return false, false, ErrUnknownHashScheme
+	}
+}
+
+func isBcrypt(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
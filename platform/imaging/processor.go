@@ -0,0 +1,168 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/imaging"
+)
+
+// maxPixels bounds the decoded size of an upload, so a small file that
+// declares huge dimensions cannot exhaust memory. A 12 MP image takes about
+// 48 MB once decoded to RGBA, and the orientation step may copy it once more.
+const maxPixels = 12_000_000
+
+// maxConcurrent bounds how many uploads are decoded at once, which keeps the
+// memory of simultaneous uploads at a few times the size of a single one.
+const maxConcurrent = 2
+
+const jpegQuality = 85
+
+type processor struct {
+	slots chan struct{}
+}
+
+// NewProcessor returns a processor that accepts JPEG and PNG uploads and
+// always produces baseline JPEGs. Transparent pixels are flattened on white.
+func NewProcessor() imaging.Processor {
+	return processor{slots: make(chan struct{}, maxConcurrent)}
+}
+
+func (p processor) Process(data []byte, maxDimension, thumbnailSize int) (imaging.Image, imaging.Image, error) {
+	p.slots <- struct{}{}
+	defer func() { <-p.slots }()
+
+	img, err := decode(data)
+	if err != nil {
+		return imaging.Image{}, imaging.Image{}, err
+	}
+
+	width, height := fit(img.Rect.Dx(), img.Rect.Dy(), maxDimension)
+	photo, err := encode(scale(img, width, height))
+	if err != nil {
+		return imaging.Image{}, imaging.Image{}, err
+	}
+
+	side := min(img.Rect.Dx(), img.Rect.Dy())
+	x := (img.Rect.Dx() - side) / 2
+	y := (img.Rect.Dy() - side) / 2
+	square := img.SubImage(image.Rect(x, y, x+side, y+side)).(*image.RGBA)
+
+	side = min(side, thumbnailSize)
+	thumbnail, err := encode(scale(square, side, side))
+	if err != nil {
+		return imaging.Image{}, imaging.Image{}, err
+	}
+
+	return photo, thumbnail, nil
+}
+
+// decode checks the real content type of the upload, decodes it and applies
+// the EXIF orientation, which is otherwise lost when the metadata is dropped.
+func decode(data []byte) (*image.RGBA, error) {
+	if !domain.IsSupportedPhotoType(http.DetectContentType(data)) {
+		return nil, domain.ErrUnsupportedPhotoType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || config.Width == 0 || config.Height == 0 {
+		return nil, domain.ErrInvalidPhoto
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, domain.ErrInvalidPhoto
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, domain.ErrInvalidPhoto
+	}
+
+	return orient(flatten(src), exifOrientation(data)), nil
+}
+
+func flatten(src image.Image) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+
+	if opaque, ok := src.(interface{ Opaque() bool }); ok && opaque.Opaque() {
+		draw.Draw(dst, dst.Rect, src, bounds.Min, draw.Src)
+		return dst
+	}
+
+	draw.Draw(dst, dst.Rect, image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Rect, src, bounds.Min, draw.Over)
+	return dst
+}
+
+// fit returns the size of a width x height image scaled down to fit within
+// maxDimension. Images that already fit keep their size.
+func fit(width, height, maxDimension int) (int, int) {
+	if width <= maxDimension && height <= maxDimension {
+		return width, height
+	}
+	if width >= height {
+		return maxDimension, max(1, height*maxDimension/width)
+	}
+	return max(1, width*maxDimension/height), maxDimension
+}
+
+// scale resizes with a box filter: every destination pixel is the average of
+// the source pixels it covers. It is only used to scale down.
+func scale(src *image.RGBA, width, height int) *image.RGBA {
+	srcWidth, srcHeight := src.Rect.Dx(), src.Rect.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	if width == srcWidth && height == srcHeight {
+		draw.Draw(dst, dst.Rect, src, src.Rect.Min, draw.Src)
+		return dst
+	}
+
+	for y := range height {
+		y0 := y * srcHeight / height
+		y1 := max(y0+1, (y+1)*srcHeight/height)
+		for x := range width {
+			x0 := x * srcWidth / width
+			x1 := max(x0+1, (x+1)*srcWidth/width)
+
+			var r, g, b, a, count int
+			for sy := y0; sy < y1; sy++ {
+				offset := src.PixOffset(src.Rect.Min.X+x0, src.Rect.Min.Y+sy)
+				for sx := x0; sx < x1; sx++ {
+					r += int(src.Pix[offset])
+					g += int(src.Pix[offset+1])
+					b += int(src.Pix[offset+2])
+					a += int(src.Pix[offset+3])
+					offset += 4
+					count++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / count)
+			dst.Pix[i+1] = uint8(g / count)
+			dst.Pix[i+2] = uint8(b / count)
+			dst.Pix[i+3] = uint8(a / count)
+		}
+	}
+
+	return dst
+}
+
+func encode(img *image.RGBA) (imaging.Image, error) {
+	var buffer bytes.Buffer
+	if err := jpeg.Encode(&buffer, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return imaging.Image{}, domain.ErrInvalidPhoto
+	}
+
+	return imaging.Image{
+		ContentType: domain.PhotoContentTypeJPEG,
+		Data:        buffer.Bytes(),
+		Width:       img.Rect.Dx(),
+		Height:      img.Rect.Dy(),
+	}, nil
+}
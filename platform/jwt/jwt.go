@@ -0,0 +1,97 @@
+package jwt
+
+import (
+	"errors"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
+	gojwt "github.com/golang-jwt/jwt/v5"
+)
+
+var ErrEmptySecretKey = errors.New("jwt secret key is empty")
+
+type jwtClaims struct {
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	Purpose   string `json:"purpose"`
+	SessionID string `json:"sid,omitempty"`
+	gojwt.RegisteredClaims
+}
+
+type generator struct {
+	secretKey []byte
+	issuer    string
+}
+
+func NewGenerator(secretKey string, issuer string) (token.Generator, error) {
+	if secretKey == "" {
+		return nil, ErrEmptySecretKey
+	}
+
+	return &generator{
+		secretKey: []byte(secretKey),
+		issuer:    issuer,
+	}, nil
+}
+
+func (g *generator) Generate(claims token.Claims, duration time.Duration) (string, error) {
+	signed, err := gojwt.NewWithClaims(gojwt.SigningMethodHS256, newJWTClaims(claims, g.issuer, duration)).SignedString(g.secretKey)
+	if err != nil {
+		return "", err
+	}
+
+	return signed, nil
+}
+
+func (g *generator) Validate(tokenString string) (*token.Claims, error) {
+	return parse(tokenString, g.issuer, []string{gojwt.SigningMethodHS256.Alg()}, func(*gojwt.Token) (interface{}, error) {
+		return g.secretKey, nil
+	})
+}
+
+func newJWTClaims(claims token.Claims, issuer string, duration time.Duration) jwtClaims {
+	now := time.Now()
+
+	return jwtClaims{
+		Email:     claims.Email,
+		Role:      claims.Role,
+		Purpose:   claims.Purpose,
+		SessionID: claims.SessionID,
+		RegisteredClaims: gojwt.RegisteredClaims{
+			Subject:   claims.ID,
+			Issuer:    issuer,
+			IssuedAt:  gojwt.NewNumericDate(now),
+			NotBefore: gojwt.NewNumericDate(now),
+			ExpiresAt: gojwt.NewNumericDate(now.Add(duration)),
+		},
+	}
+}
+
+func parse(tokenString, issuer string, methods []string, keyFunc gojwt.Keyfunc) (*token.Claims, error) {
+	options := []gojwt.ParserOption{
+		gojwt.WithValidMethods(methods),
+		gojwt.WithExpirationRequired(),
+	}
+	if issuer != "" {
+		options = append(options, gojwt.WithIssuer(issuer))
+	}
+
+	var claims jwtClaims
+	parsed, err := gojwt.ParseWithClaims(tokenString, &claims, keyFunc, options...)
+	if err != nil || !parsed.Valid {
+		return nil, domain.ErrInvalidToken
+	}
+
+	if claims.Subject == "" {
+		return nil, domain.ErrInvalidToken
+	}
+
+	return &token.Claims{
+		ID:        claims.Subject,
+		Email:     claims.Email,
+		Role:      claims.Role,
+		Purpose:   claims.Purpose,
+		SessionID: claims.SessionID,
+	}, nil
+}
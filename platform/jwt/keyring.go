@@ -0,0 +1,187 @@
+package jwt
+
+import (
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
+	gojwt "github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// unknownKeyReloadGap keeps tokens with made-up key IDs from turning
+	// into a query per request.
+	unknownKeyReloadGap = 10 * time.Second
+)
+
+var ErrNoSigningKey = errors.New("no jwt signing key available")
+
+// StaticKey is a key supplied through configuration. Without a private key
+// it is only used to verify tokens, for example while another service
+// finishes moving to a new key.
+type StaticKey struct {
+	ID         string
+	PrivateKey string
+	PublicKey  string
+}
+
+// Keyring signs tokens with the newest active key and verifies them with any
+// key that has not expired, selected by the `kid` header.
+type Keyring struct {
+	issuer string
+	static []verificationKey
+	store  ports.SigningKeyRepository
+
+	mu       sync.RWMutex
+	keys     map[string]verificationKey
+	signing  *verificationKey
+	loadedAt time.Time
+}
+
+func NewKeyring(issuer string, static []StaticKey, store ports.SigningKeyRepository) (*Keyring, error) {
+	parsed := make([]verificationKey, 0, len(static))
+	for _, key := range static {
+		verification, err := parseKey(key.ID, key.PrivateKey, key.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, verification)
+	}
+
+	keyring := &Keyring{
+		issuer: issuer,
+		static: parsed,
+		store:  store,
+	}
+	if err := keyring.Reload(); err != nil {
+		return nil, err
+	}
+
+	return keyring, nil
+}
+
+func (k *Keyring) Generate(claims token.Claims, duration time.Duration) (string, error) {
+	k.reloadIfOlderThan(token.KeyReloadInterval)
+
+	k.mu.RLock()
+	signing := k.signing
+	k.mu.RUnlock()
+
+	if signing == nil {
+		return "", ErrNoSigningKey
+	}
+
+	method, err := signingMethod(signing.algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := gojwt.NewWithClaims(method, newJWTClaims(claims, k.issuer, duration))
+	unsigned.Header["kid"] = signing.id
+
+	return unsigned.SignedString(signing.private)
+}
+
+func (k *Keyring) Validate(tokenString string) (*token.Claims, error) {
+	methods := []string{token.AlgorithmRS256, token.AlgorithmEdDSA}
+
+	return parse(tokenString, k.issuer, methods, func(parsed *gojwt.Token) (interface{}, error) {
+		kid, _ := parsed.Header["kid"].(string)
+		key, ok := k.lookup(kid)
+		if !ok {
+			k.reloadIfOlderThan(unknownKeyReloadGap)
+			key, ok = k.lookup(kid)
+		}
+		if !ok || parsed.Method.Alg() != key.algorithm {
+			return nil, domain.ErrInvalidToken
+		}
+		return key.public, nil
+	})
+}
+
+func (k *Keyring) PublicKeys() ([]token.JSONWebKey, error) {
+	k.reloadIfOlderThan(token.KeyReloadInterval)
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	keys := make([]token.JSONWebKey, 0, len(k.keys))
+	for _, key := range k.keys {
+		keys = append(keys, toJSONWebKey(key))
+	}
+	return keys, nil
+}
+
+func (k *Keyring) HasSigningKey() bool {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	return k.signing != nil
+}
+
+// Reload rebuilds the keyring from the configured keys and the keys stored
+// by rotation. Every stored key verifies, including one that is not active
+// yet; the newest stored key that can sign at now takes precedence over the
+// configured ones for signing.
+func (k *Keyring) Reload() error {
+	now := time.Now()
+	stored, err := k.store.ListVerifiable(now)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]verificationKey, len(k.static)+len(stored))
+	var signing *verificationKey
+
+	for _, key := range stored {
+		verification, err := parseKey(key.ID, key.PrivateKey, key.PublicKey)
+		if err != nil {
+			slog.Warn("Skipping unreadable jwt signing key", slog.String("kid", key.ID), slog.String("error", err.Error()))
+			continue
+		}
+		keys[key.ID] = verification
+		if signing == nil && key.CanSignAt(now) && verification.canSign() {
+			signing = &verification
+		}
+	}
+
+	for _, key := range k.static {
+		keys[key.id] = key
+		if signing == nil && key.canSign() {
+			staticKey := key
+			signing = &staticKey
+		}
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.signing = signing
+	k.loadedAt = now
+	k.mu.Unlock()
+
+	return nil
+}
+
+func (k *Keyring) lookup(kid string) (verificationKey, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	key, ok := k.keys[kid]
+	return key, ok
+}
+
+func (k *Keyring) reloadIfOlderThan(age time.Duration) {
+	k.mu.RLock()
+	stale := time.Since(k.loadedAt) >= age
+	k.mu.RUnlock()
+
+	if !stale {
+		return
+	}
+
+	if err := k.Reload(); err != nil {
+		slog.Warn("Error reloading jwt keys", slog.String("error", err.Error()))
+	}
+}
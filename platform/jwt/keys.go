@@ -0,0 +1,191 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const rsaKeyBits = 2048
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("unsupported jwt signing algorithm")
+	ErrInvalidKeyPEM        = errors.New("invalid PEM encoded key")
+	ErrUnsupportedKeyType   = errors.New("unsupported key type, use RSA or Ed25519")
+)
+
+// verificationKey is a parsed key from the keyring. Keys loaded without a
+// private part can only verify.
+type verificationKey struct {
+	id        string
+	algorithm string
+	private   crypto.Signer
+	public    crypto.PublicKey
+}
+
+func (k verificationKey) canSign() bool {
+	return k.private != nil
+}
+
+func parseKey(id, privatePEM, publicPEM string) (verificationKey, error) {
+	key := verificationKey{id: id}
+
+	if privatePEM != "" {
+		private, err := parsePrivateKey(privatePEM)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		key.private = private
+		key.public = private.Public()
+	}
+
+	if publicPEM != "" {
+		public, err := parsePublicKey(publicPEM)
+		if err != nil {
+			return verificationKey{}, err
+		}
+		key.public = public
+	}
+
+	algorithm, err := algorithmFor(key.public)
+	if err != nil {
+		return verificationKey{}, err
+	}
+	key.algorithm = algorithm
+
+	return key, nil
+}
+
+func parsePrivateKey(encoded string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, ErrInvalidKeyPEM
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, ErrUnsupportedKeyType
+		}
+		return signer, nil
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, ErrInvalidKeyPEM
+	}
+	return key, nil
+}
+
+func parsePublicKey(encoded string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, ErrInvalidKeyPEM
+	}
+
+	if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+	if err != nil {
+		return nil, ErrInvalidKeyPEM
+	}
+	return key, nil
+}
+
+func algorithmFor(public crypto.PublicKey) (string, error) {
+	switch public.(type) {
+	case *rsa.PublicKey:
+		return token.AlgorithmRS256, nil
+	case ed25519.PublicKey:
+		return token.AlgorithmEdDSA, nil
+	default:
+		return "", ErrUnsupportedKeyType
+	}
+}
+
+func signingMethod(algorithm string) (gojwt.SigningMethod, error) {
+	switch algorithm {
+	case token.AlgorithmRS256:
+		return gojwt.SigningMethodRS256, nil
+	case token.AlgorithmEdDSA:
+		return gojwt.SigningMethodEdDSA, nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+func toJSONWebKey(key verificationKey) token.JSONWebKey {
+	jwk := token.JSONWebKey{
+		KeyID:     key.id,
+		Use:       "sig",
+		Algorithm: key.algorithm,
+	}
+
+	switch public := key.public.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.Modulus = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.Exponent = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+
+	return jwk
+}
+
+type keyFactory struct{}
+
+func NewKeyFactory() token.KeyFactory {
+	return &keyFactory{}
+}
+
+func (f *keyFactory) NewKeyPair(algorithm string) (token.KeyPair, error) {
+	var private crypto.Signer
+	switch algorithm {
+	case token.AlgorithmRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return token.KeyPair{}, err
+		}
+		private = key
+	case token.AlgorithmEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return token.KeyPair{}, err
+		}
+		private = key
+	default:
+		return token.KeyPair{}, ErrUnsupportedAlgorithm
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return token.KeyPair{}, err
+	}
+
+	publicDER, err := x509.MarshalPKIXPublicKey(private.Public())
+	if err != nil {
+		return token.KeyPair{}, err
+	}
+
+	return token.KeyPair{
+		ID:         uuid.New().String(),
+		Algorithm:  algorithm,
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})),
+		PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+	}, nil
+}
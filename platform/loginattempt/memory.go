@@ -0,0 +1,107 @@
+package loginattempt
+
+import (
+	"sync"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+)
+
+type memoryStore struct {
+	mu        sync.Mutex
+	retention time.Duration
+	failures  map[string][]time.Time
+	locks     map[string]time.Time
+	lastSweep time.Time
+}
+
+// NewMemoryStore keeps attempts in process memory. Failures older than
+// retention are discarded, so it should be at least the login window. Keys
+// that saw no failure within retention are swept out at most once per
+// retention, while recording a failure.
+func NewMemoryStore(retention time.Duration) ports.LoginAttemptStore {
+	return &memoryStore{
+		retention: retention,
+		failures:  make(map[string][]time.Time),
+		locks:     make(map[string]time.Time),
+	}
+}
+
+func (s *memoryStore) RecordFailure(key string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[key] = append(prune(s.failures[key], at.Add(-s.retention)), at)
+	if at.Sub(s.lastSweep) >= s.retention {
+		s.sweep(at)
+	}
+	return nil
+}
+
+// sweep drops the keys whose newest failure is older than retention and the
+// locks that already expired.
+func (s *memoryStore) sweep(now time.Time) {
+	since := now.Add(-s.retention)
+	for key, failures := range s.failures {
+		if len(failures) == 0 || failures[len(failures)-1].Before(since) {
+			delete(s.failures, key)
+		}
+	}
+	for key, until := range s.locks {
+		if !now.Before(until) {
+			delete(s.locks, key)
+		}
+	}
+	s.lastSweep = now
+}
+
+func (s *memoryStore) Failures(key string, since time.Time) ([]time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	recent := prune(s.failures[key], since)
+	result := make([]time.Time, len(recent))
+	copy(result, recent)
+	return result, nil
+}
+
+func (s *memoryStore) Lock(key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.locks[key] = until
+	return nil
+}
+
+func (s *memoryStore) LockedUntil(key string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	until, locked := s.locks[key]
+	if !locked {
+		return time.Time{}, nil
+	}
+	if !time.Now().Before(until) {
+		delete(s.locks, key)
+		return time.Time{}, nil
+	}
+	return until, nil
+}
+
+func (s *memoryStore) Clear(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.failures, key)
+	delete(s.locks, key)
+	return nil
+}
+
+func prune(failures []time.Time, since time.Time) []time.Time {
+	for i, at := range failures {
+		if !at.Before(since) {
+			return failures[i:]
+		}
+	}
+	return nil
+}
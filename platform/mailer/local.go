@@ -0,0 +1,58 @@
+package mailer
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/ports/mailer"
+)
+
+// localMailer is used in development and tests. It writes every message as a
+// JSON file to outboxDir, or logs it to the console when no directory is set.
+type localMailer struct {
+	outboxDir string
+}
+
+func NewLocalMailer(outboxDir string) mailer.Mailer {
+	return &localMailer{
+		outboxDir: outboxDir,
+	}
+}
+
+func (m *localMailer) Send(message mailer.Message) error {
+	if m.outboxDir == "" {
+		slog.Info("Email sent to console",
+			slog.String("to", message.To),
+			slog.String("subject", message.Subject),
+			slog.String("text", message.Text))
+		return nil
+	}
+
+	if err := os.MkdirAll(m.outboxDir, 0o755); err != nil {
+		return fmt.Errorf("error creating outbox directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(message, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d-%s.json", time.Now().UnixNano(), sanitizeFileName(message.To))
+	return os.WriteFile(filepath.Join(m.outboxDir, name), data, 0o644)
+}
+
+func sanitizeFileName(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, value)
+}
@@ -0,0 +1,67 @@
+package mailer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/ports/mailer"
+)
+
+const resendEndpoint = "https://api.resend.com/emails"
+
+type resendRequest struct {
+	From    string   `json:"from"`
+	To      []string `json:"to"`
+	Subject string   `json:"subject"`
+	HTML    string   `json:"html,omitempty"`
+	Text    string   `json:"text,omitempty"`
+}
+
+type resendMailer struct {
+	apiKey    string
+	fromEmail string
+	client    *http.Client
+}
+
+func NewResendMailer(apiKey, fromEmail string) mailer.Mailer {
+	return &resendMailer{
+		apiKey:    apiKey,
+		fromEmail: fromEmail,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (m *resendMailer) Send(message mailer.Message) error {
+	body, err := json.Marshal(resendRequest{
+		From:    m.fromEmail,
+		To:      []string{message.To},
+		Subject: message.Subject,
+		HTML:    message.HTML,
+		Text:    message.Text,
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := http.NewRequest(http.MethodPost, resendEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Authorization", "Bearer "+m.apiKey)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := m.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("error sending email with resend: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("resend responded with status %d", response.StatusCode)
+	}
+
+	return nil
+}
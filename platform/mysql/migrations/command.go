@@ -0,0 +1,88 @@
+package migrations
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+const usage = "usage: migrate up | down [steps] | status"
+
+var ErrUsage = errors.New(usage)
+
+// Run executes the migrate subcommand: "up" applies the pending migrations,
+// "down" rolls back the last one or the given number of them and "status"
+// prints every migration and whether it is applied.
+func Run(db *sql.DB, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return ErrUsage
+	}
+
+	migrator, err := New(db)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		if len(args) != 1 {
+			return ErrUsage
+		}
+		applied, err := migrator.Up()
+		for _, migration := range applied {
+			fmt.Fprintf(out, "applied  %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(out, "schema is up to date")
+		}
+		return nil
+
+	case "down":
+		steps := 1
+		if len(args) == 2 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps <= 0 {
+				return ErrUsage
+			}
+		} else if len(args) > 2 {
+			return ErrUsage
+		}
+		reverted, err := migrator.Down(steps)
+		for _, migration := range reverted {
+			fmt.Fprintf(out, "reverted %04d_%s\n", migration.Version, migration.Name)
+		}
+		return err
+
+	case "status":
+		if len(args) != 1 {
+			return ErrUsage
+		}
+		statuses, err := migrator.Status()
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			fmt.Fprintf(out, "%-9s %04d_%s\n", describe(status), status.Version, status.Name)
+		}
+		return nil
+	}
+
+	return ErrUsage
+}
+
+func describe(status Status) string {
+	switch {
+	case status.IsPending():
+		return "pending"
+	case status.Migration == nil:
+		return "unknown"
+	case !status.ChecksumMatches():
+		return "modified"
+	}
+	return "applied"
+}
@@ -0,0 +1,389 @@
+// Package migrations keeps the MySQL schema in step with the code. The SQL
+// files are embedded in the binary and named <version>_<name>.up.sql and
+// <version>_<name>.down.sql; applied versions are recorded in
+// schema_migrations together with a checksum of their up file.
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+var (
+	ErrSchemaBehind     = errors.New("database schema is behind, run migrate up")
+	ErrChecksumMismatch = errors.New("applied migration differs from the embedded one")
+	ErrNoMigration      = errors.New("no applied migration to roll back")
+)
+
+// lockName serializes migrators, so two instances starting at once do not
+// apply the same migration twice.
+const (
+	lockName           = "motogo_schema_migrations"
+	lockTimeoutSeconds = 30
+)
+
+const (
+	queryCreateTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    applied_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (version)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci`
+	queryListApplied = "SELECT version, name, checksum, applied_at FROM schema_migrations ORDER BY version"
+	queryRecord      = "INSERT INTO schema_migrations (version, name, checksum, applied_at) VALUES (?, ?, ?, ?)"
+	queryForget      = "DELETE FROM schema_migrations WHERE version = ?"
+	queryLock        = "SELECT GET_LOCK(?, ?)"
+	queryUnlock      = "SELECT RELEASE_LOCK(?)"
+)
+
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+}
+
+type AppliedMigration struct {
+	Version   int
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+// Status is one line of the migrate status report. Applied is nil for
+// pending migrations; Migration is nil for versions only the database knows,
+// which a newer build applied.
+type Status struct {
+	Version   int
+	Name      string
+	Migration *Migration
+	Applied   *AppliedMigration
+}
+
+func (s Status) IsPending() bool {
+	return s.Applied == nil
+}
+
+func (s Status) ChecksumMatches() bool {
+	return s.Migration == nil || s.Applied == nil || s.Migration.Checksum == s.Applied.Checksum
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func New(db *sql.DB) (*Migrator, error) {
+	migrations, err := load(files)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Up applies every pending migration in order and returns the applied ones.
+// MySQL commits DDL implicitly, so a failing migration can leave part of its
+// statements applied; it is not recorded and has to be fixed by hand.
+func (m *Migrator) Up() ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(func(conn *sql.Conn) error {
+		statuses, err := m.status(conn)
+		if err != nil {
+			return err
+		}
+		if err := checkChecksums(statuses); err != nil {
+			return err
+		}
+
+		for _, status := range statuses {
+			if !status.IsPending() {
+				continue
+			}
+			migration := *status.Migration
+			if err := run(conn, migration.Up); err != nil {
+				return fmt.Errorf("error applying migration %04d_%s: %w", migration.Version, migration.Name, err)
+			}
+			if _, err := conn.ExecContext(context.Background(), queryRecord, migration.Version, migration.Name, migration.Checksum, time.Now()); err != nil {
+				return fmt.Errorf("error recording migration %04d_%s: %w", migration.Version, migration.Name, err)
+			}
+			slog.Info("Applied migration", slog.Int("version", migration.Version), slog.String("name", migration.Name))
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down rolls back the last steps applied migrations, newest first.
+func (m *Migrator) Down(steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.withLock(func(conn *sql.Conn) error {
+		statuses, err := m.status(conn)
+		if err != nil {
+			return err
+		}
+		if err := checkChecksums(statuses); err != nil {
+			return err
+		}
+
+		for i := len(statuses) - 1; i >= 0 && len(reverted) < steps; i-- {
+			status := statuses[i]
+			if status.IsPending() {
+				continue
+			}
+			if status.Migration == nil {
+				return fmt.Errorf("migration %04d_%s is not known to this build and cannot be rolled back", status.Version, status.Name)
+			}
+
+			migration := *status.Migration
+			if err := run(conn, migration.Down); err != nil {
+				return fmt.Errorf("error rolling back migration %04d_%s: %w", migration.Version, migration.Name, err)
+			}
+			if _, err := conn.ExecContext(context.Background(), queryForget, migration.Version); err != nil {
+				return fmt.Errorf("error forgetting migration %04d_%s: %w", migration.Version, migration.Name, err)
+			}
+			slog.Info("Rolled back migration", slog.Int("version", migration.Version), slog.String("name", migration.Name))
+			reverted = append(reverted, migration)
+		}
+
+		if len(reverted) == 0 {
+			return ErrNoMigration
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status lists the embedded and the applied migrations by version.
+func (m *Migrator) Status() ([]Status, error) {
+	conn, err := m.db.Conn(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(context.Background(), queryCreateTable); err != nil {
+		return nil, fmt.Errorf("error creating schema_migrations: %w", err)
+	}
+	return m.status(conn)
+}
+
+// EnsureCurrent fails when a migration is pending or an applied migration
+// was edited after it ran. Versions applied by a newer build are tolerated,
+// so a rollback of the code does not need a rollback of the schema.
+func (m *Migrator) EnsureCurrent() error {
+	statuses, err := m.Status()
+	if err != nil {
+		return err
+	}
+	if err := checkChecksums(statuses); err != nil {
+		return err
+	}
+
+	var pending []string
+	for _, status := range statuses {
+		if status.IsPending() {
+			pending = append(pending, fmt.Sprintf("%04d_%s", status.Version, status.Name))
+		}
+		if status.Migration == nil {
+			slog.Warn("Database has a migration unknown to this build",
+				slog.Int("version", status.Version),
+				slog.String("name", status.Name))
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: pending %s", ErrSchemaBehind, strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+func (m *Migrator) status(conn *sql.Conn) ([]Status, error) {
+	rows, err := conn.QueryContext(context.Background(), queryListApplied)
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]*AppliedMigration)
+	for rows.Next() {
+		var migration AppliedMigration
+		if err := rows.Scan(&migration.Version, &migration.Name, &migration.Checksum, &migration.AppliedAt); err != nil {
+			return nil, fmt.Errorf("error reading schema_migrations: %w", err)
+		}
+		applied[migration.Version] = &migration
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error reading schema_migrations: %w", err)
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for i := range m.migrations {
+		migration := &m.migrations[i]
+		statuses = append(statuses, Status{
+			Version:   migration.Version,
+			Name:      migration.Name,
+			Migration: migration,
+			Applied:   applied[migration.Version],
+		})
+		delete(applied, migration.Version)
+	}
+	for _, migration := range applied {
+		statuses = append(statuses, Status{Version: migration.Version, Name: migration.Name, Applied: migration})
+	}
+	slices.SortFunc(statuses, func(a, b Status) int { return a.Version - b.Version })
+
+	return statuses, nil
+}
+
+// withLock runs fn on one connection holding the migration lock, since MySQL
+// named locks belong to the connection that took them.
+func (m *Migrator) withLock(fn func(conn *sql.Conn) error) error {
+	ctx := context.Background()
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var locked sql.NullInt64
+	if err := conn.QueryRowContext(ctx, queryLock, lockName, lockTimeoutSeconds).Scan(&locked); err != nil {
+		return fmt.Errorf("error taking migration lock: %w", err)
+	}
+	if !locked.Valid || locked.Int64 != 1 {
+		return fmt.Errorf("another migration is running")
+	}
+	defer conn.ExecContext(ctx, queryUnlock, lockName)
+
+	if _, err := conn.ExecContext(ctx, queryCreateTable); err != nil {
+		return fmt.Errorf("error creating schema_migrations: %w", err)
+	}
+
+	return fn(conn)
+}
+
+func checkChecksums(statuses []Status) error {
+	for _, status := range statuses {
+		if !status.ChecksumMatches() {
+			return fmt.Errorf("%w: %04d_%s", ErrChecksumMismatch, status.Version, status.Name)
+		}
+	}
+	return nil
+}
+
+func run(conn *sql.Conn, script string) error {
+	for _, statement := range splitStatements(script) {
+		if _, err := conn.ExecContext(context.Background(), statement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitStatements cuts a script at the semicolons that end a line, since the
+// driver runs one statement per call. Comment lines are dropped.
+func splitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+
+	for line := range strings.Lines(script) {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		current.WriteString(line)
+		if strings.HasSuffix(trimmed, ";") {
+			statement := strings.TrimSuffix(strings.TrimSpace(current.String()), ";")
+			statements = append(statements, statement)
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+
+	return statements
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	names, err := fs.Glob(fsys, "sql/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	seen := make(map[string]string)
+	for _, name := range names {
+		base := path.Base(name)
+		stem, direction, ok := strings.Cut(strings.TrimSuffix(base, ".sql"), ".")
+		if !ok || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("invalid migration file name %s", base)
+		}
+		versionText, migrationName, ok := strings.Cut(stem, "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name %s", base)
+		}
+		version, err := strconv.Atoi(versionText)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %s", base)
+		}
+
+		content, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: migrationName}
+			byVersion[version] = migration
+		}
+		if migration.Name != migrationName {
+			return nil, fmt.Errorf("migration %d has two names: %s and %s", version, migration.Name, migrationName)
+		}
+
+		// Versions are compared as numbers, so 0002_x and 2_x are the same
+		// migration and one would silently replace the other.
+		key := strconv.Itoa(version) + "." + direction
+		if other, ok := seen[key]; ok {
+			return nil, fmt.Errorf("migration %d has two %s files: %s and %s", version, direction, other, base)
+		}
+		seen[key] = base
+
+		if direction == "up" {
+			migration.Up = string(content)
+			sum := sha256.Sum256(content)
+			migration.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int { return a.Version - b.Version })
+
+	return migrations, nil
+}
@@ -0,0 +1,173 @@
+package migrations
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{
+			name:   "one per line",
+			script: "DROP TABLE a;\nDROP TABLE b;\n",
+			want:   []string{"DROP TABLE a", "DROP TABLE b"},
+		},
+		{
+			name: "multi-line statement",
+			script: "CREATE TABLE a (\n" +
+				"    id INT NOT NULL,\n" +
+				"    PRIMARY KEY (id)\n" +
+				");\n" +
+				"UPDATE a SET id = 1;\n",
+			want: []string{
+				"CREATE TABLE a (\n    id INT NOT NULL,\n    PRIMARY KEY (id)\n)",
+				"UPDATE a SET id = 1",
+			},
+		},
+		{
+			name: "comments and blank lines",
+			script: "-- The backfill follows the domain rules;\n" +
+				"\n" +
+				"ALTER TABLE a ADD COLUMN b INT;\n" +
+				"    -- indented comment;\n" +
+				"UPDATE a\n" +
+				"-- between lines\n" +
+				"SET b = 1;\n",
+			want: []string{"ALTER TABLE a ADD COLUMN b INT", "UPDATE a\nSET b = 1"},
+		},
+		{
+			name:   "semicolon inside a line",
+			script: "UPDATE a SET note = 'x;y' WHERE id = 1;\n",
+			want:   []string{"UPDATE a SET note = 'x;y' WHERE id = 1"},
+		},
+		{
+			name:   "no trailing semicolon",
+			script: "DROP TABLE a;\nDROP TABLE b\n",
+			want:   []string{"DROP TABLE a", "DROP TABLE b"},
+		},
+		{
+			name:   "only comments",
+			script: "-- nothing to do\n\n",
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitStatements(tt.script); !slices.Equal(got, tt.want) {
+				t.Errorf("splitStatements() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sql/0002_add_b.up.sql":      {Data: []byte("ALTER TABLE a ADD COLUMN b INT;\n")},
+		"sql/0002_add_b.down.sql":    {Data: []byte("ALTER TABLE a DROP COLUMN b;\n")},
+		"sql/0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id INT);\n")},
+		"sql/0001_create_a.down.sql": {Data: []byte("DROP TABLE a;\n")},
+	}
+
+	migrations, err := load(fsys)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	if len(migrations) != 2 {
+		t.Fatalf("loaded %d migrations, want 2", len(migrations))
+	}
+	first, second := migrations[0], migrations[1]
+	if first.Version != 1 || first.Name != "create_a" || second.Version != 2 || second.Name != "add_b" {
+		t.Errorf("migrations = %d_%s, %d_%s, want 1_create_a, 2_add_b", first.Version, first.Name, second.Version, second.Name)
+	}
+	if first.Up != "CREATE TABLE a (id INT);\n" || first.Down != "DROP TABLE a;\n" {
+		t.Errorf("migration 1 up = %q, down = %q", first.Up, first.Down)
+	}
+	if first.Checksum == "" || first.Checksum == second.Checksum {
+		t.Errorf("checksums = %q and %q, want distinct checksums of the up files", first.Checksum, second.Checksum)
+	}
+}
+
+func TestLoadRejectsInvalidSets(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		wantErr string
+	}{
+		{
+			name: "missing down file",
+			files: fstest.MapFS{
+				"sql/0001_create_a.up.sql": {Data: []byte("CREATE TABLE a (id INT);\n")},
+			},
+			wantErr: "needs both an up and a down file",
+		},
+		{
+			name: "missing up file",
+			files: fstest.MapFS{
+				"sql/0001_create_a.down.sql": {Data: []byte("DROP TABLE a;\n")},
+			},
+			wantErr: "needs both an up and a down file",
+		},
+		{
+			name: "duplicate version with another name",
+			files: fstest.MapFS{
+				"sql/0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id INT);\n")},
+				"sql/0001_create_a.down.sql": {Data: []byte("DROP TABLE a;\n")},
+				"sql/0001_create_b.up.sql":   {Data: []byte("CREATE TABLE b (id INT);\n")},
+				"sql/0001_create_b.down.sql": {Data: []byte("DROP TABLE b;\n")},
+			},
+			wantErr: "has two names",
+		},
+		{
+			name: "duplicate version with other padding",
+			files: fstest.MapFS{
+				"sql/0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id INT);\n")},
+				"sql/0001_create_a.down.sql": {Data: []byte("DROP TABLE a;\n")},
+				"sql/1_create_a.up.sql":      {Data: []byte("CREATE TABLE a (id BIGINT);\n")},
+			},
+			wantErr: "has two up files",
+		},
+		{
+			name: "unknown direction",
+			files: fstest.MapFS{
+				"sql/0001_create_a.sideways.sql": {Data: []byte("SELECT 1;\n")},
+			},
+			wantErr: "invalid migration file name",
+		},
+		{
+			name: "invalid version",
+			files: fstest.MapFS{
+				"sql/first_create_a.up.sql": {Data: []byte("SELECT 1;\n")},
+			},
+			wantErr: "invalid migration version",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := load(tt.files)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("load() error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadEmbeddedMigrations(t *testing.T) {
+	migrations, err := load(files)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	for i, migration := range migrations {
+		if migration.Version != i+1 {
+			t.Errorf("migration %d_%s found at position %d, want contiguous versions", migration.Version, migration.Name, i+1)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+-- The persons table as the first version of the API created it. Databases
+-- that were set up by hand already have it and keep their rows.
+CREATE TABLE IF NOT EXISTS persons (
+    id CHAR(36) NOT NULL,
+    identity_number VARCHAR(20) NOT NULL,
+    first_name VARCHAR(120) NOT NULL,
+    last_name VARCHAR(120) NOT NULL,
+    second_last_name VARCHAR(120) NOT NULL DEFAULT '',
+    email VARCHAR(250) NOT NULL,
+    phone_number VARCHAR(20) NOT NULL,
+    email_verified BOOLEAN NOT NULL DEFAULT FALSE,
+    phone_number_verified BOOLEAN NOT NULL DEFAULT FALSE,
+    password VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL DEFAULT 'passenger',
+    PRIMARY KEY (id),
+    UNIQUE KEY uq_persons_email (email)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
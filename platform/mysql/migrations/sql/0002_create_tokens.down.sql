@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS phone_otps;
+DROP TABLE IF EXISTS verification_tokens;
+DROP TABLE IF EXISTS refresh_tokens;
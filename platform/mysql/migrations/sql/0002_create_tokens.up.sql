@@ -0,0 +1,40 @@
+CREATE TABLE refresh_tokens (
+    id CHAR(36) NOT NULL,
+    person_id CHAR(36) NOT NULL,
+    family_id CHAR(36) NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    expires_at DATETIME(6) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    used_at DATETIME(6) NULL,
+    revoked_at DATETIME(6) NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uq_refresh_tokens_hash (token_hash),
+    KEY idx_refresh_tokens_family (family_id),
+    KEY idx_refresh_tokens_person (person_id)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
+
+CREATE TABLE verification_tokens (
+    id CHAR(36) NOT NULL,
+    person_id CHAR(36) NOT NULL,
+    purpose VARCHAR(32) NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    expires_at DATETIME(6) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    used_at DATETIME(6) NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uq_verification_tokens_hash (token_hash),
+    KEY idx_verification_tokens_person (person_id, purpose)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
+
+CREATE TABLE phone_otps (
+    id CHAR(36) NOT NULL,
+    person_id CHAR(36) NOT NULL,
+    phone_number VARCHAR(20) NOT NULL,
+    code_hash CHAR(64) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    expires_at DATETIME(6) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    consumed_at DATETIME(6) NULL,
+    PRIMARY KEY (id),
+    KEY idx_phone_otps_person (person_id, created_at)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
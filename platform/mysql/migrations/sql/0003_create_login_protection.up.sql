@@ -0,0 +1,15 @@
+-- Attempt keys are "email:<canonical email>", "ip:<address>" or
+-- "mfa:<person id>".
+CREATE TABLE login_failures (
+    id BIGINT NOT NULL AUTO_INCREMENT,
+    attempt_key VARCHAR(320) NOT NULL,
+    failed_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (id),
+    KEY idx_login_failures_key (attempt_key, failed_at)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
+
+CREATE TABLE login_lockouts (
+    attempt_key VARCHAR(320) NOT NULL,
+    locked_until DATETIME(6) NOT NULL,
+    PRIMARY KEY (attempt_key)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
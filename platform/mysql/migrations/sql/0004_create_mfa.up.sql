@@ -0,0 +1,24 @@
+CREATE TABLE mfa_totp_factors (
+    person_id CHAR(36) NOT NULL,
+    secret_ciphertext VARCHAR(255) NOT NULL,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at DATETIME(6) NOT NULL,
+    confirmed_at DATETIME(6) NULL,
+    PRIMARY KEY (person_id)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
+
+CREATE TABLE mfa_recovery_codes (
+    id CHAR(36) NOT NULL,
+    person_id CHAR(36) NOT NULL,
+    code_hash CHAR(64) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    used_at DATETIME(6) NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uq_mfa_recovery_codes_hash (person_id, code_hash)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
+
+CREATE TABLE mfa_role_policies (
+    role VARCHAR(20) NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (role)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
@@ -0,0 +1,41 @@
+CREATE TABLE sessions (
+    id CHAR(36) NOT NULL,
+    person_id CHAR(36) NOT NULL,
+    device_name VARCHAR(255) NOT NULL DEFAULT '',
+    platform VARCHAR(32) NOT NULL DEFAULT '',
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    last_seen_at DATETIME(6) NOT NULL,
+    revoked_at DATETIME(6) NULL,
+    PRIMARY KEY (id),
+    KEY idx_sessions_person (person_id, last_seen_at)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
+
+CREATE TABLE jwt_signing_keys (
+    kid VARCHAR(64) NOT NULL,
+    algorithm VARCHAR(16) NOT NULL,
+    private_key_ciphertext TEXT NOT NULL,
+    public_key_pem TEXT NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    retired_at DATETIME(6) NULL,
+    verify_until DATETIME(6) NULL,
+    PRIMARY KEY (kid),
+    KEY idx_jwt_signing_keys_created (created_at)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
+
+CREATE TABLE api_keys (
+    id CHAR(36) NOT NULL,
+    name VARCHAR(120) NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    key_hash CHAR(64) NOT NULL,
+    scopes VARCHAR(255) NOT NULL DEFAULT '',
+    created_by CHAR(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    expires_at DATETIME(6) NULL,
+    revoked_at DATETIME(6) NULL,
+    last_used_at DATETIME(6) NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uq_api_keys_hash (key_hash),
+    KEY idx_api_keys_created_by (created_by)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
@@ -0,0 +1,20 @@
+CREATE TABLE external_identities (
+    provider VARCHAR(64) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    person_id CHAR(36) NOT NULL,
+    email VARCHAR(250) NOT NULL DEFAULT '',
+    created_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (provider, subject),
+    KEY idx_external_identities_person (person_id)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
+
+CREATE TABLE oidc_login_states (
+    state VARCHAR(64) NOT NULL,
+    provider VARCHAR(64) NOT NULL,
+    nonce VARCHAR(64) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    expires_at DATETIME(6) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    PRIMARY KEY (state),
+    KEY idx_oidc_login_states_expires (expires_at)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
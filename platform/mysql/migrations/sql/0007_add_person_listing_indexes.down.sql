@@ -0,0 +1,4 @@
+ALTER TABLE persons
+    DROP KEY idx_persons_role,
+    DROP KEY idx_persons_last_name,
+    DROP KEY idx_persons_first_name;
@@ -0,0 +1,6 @@
+-- Keyset pagination of the admin listing reads ranges of (sort column, id).
+-- Sorting by email uses the unique email index.
+ALTER TABLE persons
+    ADD KEY idx_persons_first_name (first_name, id),
+    ADD KEY idx_persons_last_name (last_name, id),
+    ADD KEY idx_persons_role (role);
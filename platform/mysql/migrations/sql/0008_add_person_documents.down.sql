@@ -0,0 +1,7 @@
+ALTER TABLE persons DROP KEY uq_persons_document;
+
+UPDATE persons SET identity_number = '' WHERE identity_number IS NULL;
+
+ALTER TABLE persons
+    DROP COLUMN document_type,
+    MODIFY COLUMN identity_number VARCHAR(20) NOT NULL;
@@ -0,0 +1,10 @@
+ALTER TABLE persons
+    ADD COLUMN document_type VARCHAR(5) NULL AFTER id,
+    MODIFY COLUMN identity_number VARCHAR(20) NULL;
+
+-- Empty documents become NULL so they do not collide on the unique index,
+-- and the numbers registered before document types were cédulas.
+UPDATE persons SET identity_number = NULL WHERE identity_number = '';
+UPDATE persons SET document_type = 'CC' WHERE identity_number IS NOT NULL;
+
+ALTER TABLE persons ADD UNIQUE KEY uq_persons_document (document_type, identity_number);
@@ -0,0 +1,8 @@
+-- Numbers keep their E.164 form; only the column definitions are reverted.
+ALTER TABLE persons DROP KEY uq_persons_phone;
+
+UPDATE persons SET phone_number = '' WHERE phone_number IS NULL;
+
+ALTER TABLE persons
+    DROP COLUMN country,
+    MODIFY COLUMN phone_number VARCHAR(20) NOT NULL;
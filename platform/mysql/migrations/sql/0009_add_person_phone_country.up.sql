@@ -0,0 +1,12 @@
+ALTER TABLE persons
+    MODIFY COLUMN phone_number VARCHAR(20) NULL,
+    ADD COLUMN country CHAR(2) NOT NULL DEFAULT '' AFTER phone_number;
+
+UPDATE persons SET phone_number = NULL WHERE phone_number = '';
+
+-- Numbers stored before E.164 were Colombian mobiles without the calling
+-- code. Any other format is left as is and normalized on the next update.
+UPDATE persons SET phone_number = CONCAT('+57', phone_number), country = 'CO'
+WHERE phone_number REGEXP '^3[0-9]{9}$';
+
+ALTER TABLE persons ADD UNIQUE KEY uq_persons_phone (phone_number);
@@ -0,0 +1,3 @@
+ALTER TABLE persons
+    DROP KEY uq_persons_email_canonical,
+    DROP COLUMN email_canonical;
@@ -0,0 +1,20 @@
+-- The backfill follows domain.CanonicalEmail. Run the emailcollisions
+-- command first: accounts that share a canonical email make the unique
+-- index fail and have to be merged or edited by hand.
+ALTER TABLE persons ADD COLUMN email_canonical VARCHAR(250) NULL AFTER email;
+
+UPDATE persons SET email_canonical = LOWER(TRIM(email));
+
+UPDATE persons
+SET email_canonical = CONCAT(REPLACE(SUBSTRING_INDEX(SUBSTRING_INDEX(email_canonical, '@', 1), '+', 1), '.', ''), '@gmail.com')
+WHERE SUBSTRING_INDEX(email_canonical, '@', -1) IN ('gmail.com', 'googlemail.com')
+    AND email_canonical NOT LIKE '+%';
+
+UPDATE persons
+SET email_canonical = CONCAT(SUBSTRING_INDEX(SUBSTRING_INDEX(email_canonical, '@', 1), '+', 1), '@', SUBSTRING_INDEX(email_canonical, '@', -1))
+WHERE SUBSTRING_INDEX(email_canonical, '@', -1) IN ('outlook.com', 'hotmail.com', 'live.com')
+    AND email_canonical NOT LIKE '+%';
+
+ALTER TABLE persons
+    MODIFY COLUMN email_canonical VARCHAR(250) NOT NULL,
+    ADD UNIQUE KEY uq_persons_email_canonical (email_canonical);
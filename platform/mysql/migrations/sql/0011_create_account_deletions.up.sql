@@ -0,0 +1,8 @@
+CREATE TABLE account_deletions (
+    person_id CHAR(36) NOT NULL,
+    requested_at DATETIME(6) NOT NULL,
+    scheduled_for DATETIME(6) NOT NULL,
+    completed_at DATETIME(6) NULL,
+    PRIMARY KEY (person_id),
+    KEY idx_account_deletions_due (completed_at, scheduled_for)
+) ENGINE = InnoDB DEFAULT CHARSET = utf8mb4 COLLATE = utf8mb4_unicode_ci;
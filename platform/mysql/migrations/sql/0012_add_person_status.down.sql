@@ -0,0 +1,5 @@
+ALTER TABLE persons
+    DROP KEY idx_persons_status,
+    DROP COLUMN status_expires_at,
+    DROP COLUMN status_reason,
+    DROP COLUMN status;
@@ -0,0 +1,10 @@
+ALTER TABLE persons
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'active' AFTER role,
+    ADD COLUMN status_reason VARCHAR(500) NOT NULL DEFAULT '' AFTER status,
+    ADD COLUMN status_expires_at DATETIME(6) NULL AFTER status_reason,
+    ADD KEY idx_persons_status (status);
+
+-- Persons waiting for their deletion grace period to end.
+UPDATE persons
+SET status = 'pending_deletion'
+WHERE id IN (SELECT person_id FROM account_deletions WHERE completed_at IS NULL);
@@ -0,0 +1,4 @@
+ALTER TABLE persons
+    DROP COLUMN photo_thumbnail_url,
+    DROP COLUMN photo_url,
+    DROP COLUMN photo_key;
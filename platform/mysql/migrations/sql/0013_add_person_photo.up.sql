@@ -0,0 +1,4 @@
+ALTER TABLE persons
+    ADD COLUMN photo_key VARCHAR(255) NOT NULL DEFAULT '' AFTER status_expires_at,
+    ADD COLUMN photo_url VARCHAR(1024) NOT NULL DEFAULT '' AFTER photo_key,
+    ADD COLUMN photo_thumbnail_url VARCHAR(1024) NOT NULL DEFAULT '' AFTER photo_url;
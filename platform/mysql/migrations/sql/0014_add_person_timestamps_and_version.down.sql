@@ -0,0 +1,5 @@
+ALTER TABLE persons
+    DROP COLUMN version,
+    DROP COLUMN deleted_at,
+    DROP COLUMN updated_at,
+    DROP COLUMN created_at;
@@ -0,0 +1,11 @@
+-- Existing persons get the migration time as their creation time.
+ALTER TABLE persons
+    ADD COLUMN created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    ADD COLUMN updated_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    ADD COLUMN deleted_at DATETIME(6) NULL,
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- Anonymized accounts are deleted.
+UPDATE persons
+SET deleted_at = (SELECT completed_at FROM account_deletions WHERE account_deletions.person_id = persons.id)
+WHERE id IN (SELECT person_id FROM account_deletions WHERE completed_at IS NOT NULL);
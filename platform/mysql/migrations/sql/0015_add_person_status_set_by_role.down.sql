@@ -0,0 +1,2 @@
+ALTER TABLE persons
+    DROP COLUMN status_set_by_role;
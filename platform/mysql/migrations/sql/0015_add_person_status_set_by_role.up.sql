@@ -0,0 +1,4 @@
+-- Role of the staff member who set the current status; empty for statuses
+-- the person entered on their own, such as a deletion request.
+ALTER TABLE persons
+    ADD COLUMN status_set_by_role VARCHAR(20) NOT NULL DEFAULT '' AFTER status_expires_at;
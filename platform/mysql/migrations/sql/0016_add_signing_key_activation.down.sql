@@ -0,0 +1,2 @@
+ALTER TABLE jwt_signing_keys
+    DROP COLUMN activates_at;
@@ -0,0 +1,7 @@
+-- Keys sign from activates_at on, so a new key is published before any
+-- token carries it. Existing keys were active since they were created.
+ALTER TABLE jwt_signing_keys
+    ADD COLUMN activates_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) AFTER created_at;
+
+UPDATE jwt_signing_keys
+SET activates_at = created_at;
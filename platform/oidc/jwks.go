@@ -0,0 +1,62 @@
+package oidc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+)
+
+type jsonWebKey struct {
+	KeyType  string `json:"kty"`
+	KeyID    string `json:"kid"`
+	Modulus  string `json:"n"`
+	Exponent string `json:"e"`
+	Curve    string `json:"crv"`
+	X        string `json:"x"`
+	Y        string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// publicKeys returns the RSA and P-256 keys of the set by key ID. Keys of
+// other types are skipped.
+func (s jsonWebKeySet) publicKeys() map[string]crypto.PublicKey {
+	keys := make(map[string]crypto.PublicKey, len(s.Keys))
+
+	for _, key := range s.Keys {
+		switch key.KeyType {
+		case "RSA":
+			n, errN := decodeBigInt(key.Modulus)
+			e, errE := decodeBigInt(key.Exponent)
+			if errN != nil || errE != nil || !e.IsInt64() {
+				continue
+			}
+			keys[key.KeyID] = &rsa.PublicKey{N: n, E: int(e.Int64())}
+		case "EC":
+			if key.Curve != "P-256" {
+				continue
+			}
+			x, errX := decodeBigInt(key.X)
+			y, errY := decodeBigInt(key.Y)
+			if errX != nil || errY != nil {
+				continue
+			}
+			keys[key.KeyID] = &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+		}
+	}
+
+	return keys
+}
+
+func decodeBigInt(encoded string) (*big.Int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(raw), nil
+}
@@ -0,0 +1,261 @@
+package oidc
+
+import (
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/ports/oidc"
+	gojwt "github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	httpTimeout = 10 * time.Second
+	// jwksRefreshGap keeps ID tokens with unknown key IDs from refetching the
+	// provider keys on every login.
+	jwksRefreshGap = time.Minute
+)
+
+var (
+	ErrDiscoveryFailed = errors.New("oidc discovery failed")
+	ErrIssuerMismatch  = errors.New("oidc discovery issuer does not match configuration")
+	ErrTokenExchange   = errors.New("oidc token exchange failed")
+	ErrInvalidIDToken  = errors.New("invalid oidc id token")
+	ErrNonceMismatch   = errors.New("oidc id token nonce does not match")
+)
+
+var defaultScopes = []string{"openid", "email", "profile"}
+
+type ProviderConfig struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+}
+
+type idTokenClaims struct {
+	Nonce         string       `json:"nonce"`
+	Email         string       `json:"email"`
+	EmailVerified flexibleBool `json:"email_verified"`
+	GivenName     string       `json:"given_name"`
+	FamilyName    string       `json:"family_name"`
+	gojwt.RegisteredClaims
+}
+
+// flexibleBool accepts booleans sent as JSON strings, as some providers do
+// for email_verified.
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	*b = flexibleBool(strings.Trim(string(data), `"`) == "true")
+	return nil
+}
+
+// provider is a generic OpenID Connect relying party. Endpoints and keys
+// are discovered from the issuer and cached.
+type provider struct {
+	config ProviderConfig
+	client *http.Client
+
+	mu            sync.Mutex
+	discovery     *discoveryDocument
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+// NewProvider builds a provider. A nil client uses a default HTTP client;
+// tests pass the one of the fake identity provider, which never leaves the
+// process.
+func NewProvider(config ProviderConfig, client *http.Client) oidc.Provider {
+	if client == nil {
+		client = &http.Client{Timeout: httpTimeout}
+	}
+	if len(config.Scopes) == 0 {
+		config.Scopes = defaultScopes
+	}
+
+	return &provider{
+		config: config,
+		client: client,
+	}
+}
+
+func (p *provider) Name() string {
+	return p.config.Name
+}
+
+func (p *provider) AuthorizationURL(request oidc.AuthorizationRequest) (string, error) {
+	discovery, err := p.discover()
+	if err != nil {
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", p.config.RedirectURL)
+	query.Set("scope", strings.Join(p.config.Scopes, " "))
+	query.Set("state", request.State)
+	query.Set("nonce", request.Nonce)
+	query.Set("code_challenge", request.CodeChallenge)
+	query.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return discovery.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+func (p *provider) Exchange(code, codeVerifier, nonce string) (oidc.Identity, error) {
+	discovery, err := p.discover()
+	if err != nil {
+		return oidc.Identity{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("client_id", p.config.ClientID)
+	form.Set("client_secret", p.config.ClientSecret)
+	form.Set("code_verifier", codeVerifier)
+
+	response, err := p.client.PostForm(discovery.TokenEndpoint, form)
+	if err != nil {
+		return oidc.Identity{}, fmt.Errorf("%w: %v", ErrTokenExchange, err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return oidc.Identity{}, fmt.Errorf("%w: status %d: %s", ErrTokenExchange, response.StatusCode, body)
+	}
+
+	var tokens tokenResponse
+	if err := json.NewDecoder(response.Body).Decode(&tokens); err != nil || tokens.IDToken == "" {
+		return oidc.Identity{}, ErrTokenExchange
+	}
+
+	claims, err := p.validateIDToken(tokens.IDToken)
+	if err != nil {
+		return oidc.Identity{}, err
+	}
+
+	if claims.Nonce != nonce {
+		return oidc.Identity{}, ErrNonceMismatch
+	}
+
+	return oidc.Identity{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		GivenName:     claims.GivenName,
+		FamilyName:    claims.FamilyName,
+	}, nil
+}
+
+func (p *provider) validateIDToken(idToken string) (*idTokenClaims, error) {
+	var claims idTokenClaims
+	parsed, err := gojwt.ParseWithClaims(idToken, &claims, func(token *gojwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(kid)
+	},
+		gojwt.WithValidMethods([]string{"RS256", "ES256"}),
+		gojwt.WithIssuer(p.config.Issuer),
+		gojwt.WithAudience(p.config.ClientID),
+		gojwt.WithExpirationRequired(),
+	)
+	if err != nil || !parsed.Valid || claims.Subject == "" {
+		return nil, ErrInvalidIDToken
+	}
+
+	return &claims, nil
+}
+
+func (p *provider) discover() (*discoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var document discoveryDocument
+	if err := p.getJSON(strings.TrimSuffix(p.config.Issuer, "/")+"/.well-known/openid-configuration", &document); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDiscoveryFailed, err)
+	}
+
+	if document.Issuer != p.config.Issuer {
+		return nil, ErrIssuerMismatch
+	}
+
+	p.discovery = &document
+	return p.discovery, nil
+}
+
+func (p *provider) key(kid string) (crypto.PublicKey, error) {
+	discovery, err := p.discover()
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+
+	if time.Since(p.keysFetchedAt) < jwksRefreshGap {
+		return nil, ErrInvalidIDToken
+	}
+
+	var set jsonWebKeySet
+	if err := p.getJSON(discovery.JWKSURI, &set); err != nil {
+		return nil, err
+	}
+
+	p.keys = set.publicKeys()
+	p.keysFetchedAt = time.Now()
+
+	key, ok := p.keys[kid]
+	if !ok {
+		return nil, ErrInvalidIDToken
+	}
+	return key, nil
+}
+
+func (p *provider) getJSON(endpoint string, target any) error {
+	response, err := p.client.Get(endpoint)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", response.StatusCode, endpoint)
+	}
+
+	return json.NewDecoder(response.Body).Decode(target)
+}
@@ -11,8 +11,22 @@ import (
 )
 
 type Validators struct {
-	FileReader        FileReaderInterface
-	RegisterValidator *jsonschema.Schema
+	FileReader              FileReaderInterface
+	RegisterValidator       *jsonschema.Schema
+	LoginValidator          *jsonschema.Schema
+	RefreshValidator        *jsonschema.Schema
+	VerifyEmailValidator    *jsonschema.Schema
+	EmailValidator          *jsonschema.Schema
+	PhoneOTPValidator       *jsonschema.Schema
+	AssignRoleValidator     *jsonschema.Schema
+	ResetPasswordValidator  *jsonschema.Schema
+	ChangePasswordValidator *jsonschema.Schema
+	MFALoginValidator       *jsonschema.Schema
+	MFACodeValidator        *jsonschema.Schema
+	MFARolePolicyValidator  *jsonschema.Schema
+	CreateAPIKeyValidator   *jsonschema.Schema
+	UpdatePersonValidator   *jsonschema.Schema
+	AccountStatusValidator  *jsonschema.Schema
 }
 
 type FileReaderInterface interface {
@@ -51,6 +65,104 @@ func NewValidator(fileReader FileReaderInterface) (*Validators, error) {
 
 	validator.RegisterValidator = register
 
+	login, err := validator.createSchema("login_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.LoginValidator = login
+
+	refresh, err := validator.createSchema("refresh_token_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.RefreshValidator = refresh
+
+	verifyEmail, err := validator.createSchema("verify_email_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.VerifyEmailValidator = verifyEmail
+
+	email, err := validator.createSchema("email_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.EmailValidator = email
+
+	phoneOTP, err := validator.createSchema("phone_otp_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.PhoneOTPValidator = phoneOTP
+
+	assignRole, err := validator.createSchema("assign_role_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.AssignRoleValidator = assignRole
+
+	resetPassword, err := validator.createSchema("reset_password_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.ResetPasswordValidator = resetPassword
+
+	changePassword, err := validator.createSchema("change_password_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.ChangePasswordValidator = changePassword
+
+	mfaLogin, err := validator.createSchema("mfa_login_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.MFALoginValidator = mfaLogin
+
+	mfaCode, err := validator.createSchema("mfa_code_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.MFACodeValidator = mfaCode
+
+	mfaRolePolicy, err := validator.createSchema("mfa_role_policy_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.MFARolePolicyValidator = mfaRolePolicy
+
+	createAPIKey, err := validator.createSchema("create_api_key_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.CreateAPIKeyValidator = createAPIKey
+
+	updatePerson, err := validator.createSchema("update_person_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.UpdatePersonValidator = updatePerson
+
+	accountStatus, err := validator.createSchema("account_status_schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	validator.AccountStatusValidator = accountStatus
+
 	return validator, nil
 
 }
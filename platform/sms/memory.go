@@ -0,0 +1,75 @@
+package sms
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// maxStoredMessages bounds the messages kept in memory; older ones are
+// dropped first.
+const maxStoredMessages = 100
+
+type Message struct {
+	PhoneNumber string
+	Body        string
+	SentAt      time.Time
+}
+
+// MemorySender keeps the latest SMS in memory instead of delivering them. It
+// is the adapter used in development and tests. The bodies carry one-time
+// codes, so they are only logged when logBodies is set.
+type MemorySender struct {
+	mu        sync.Mutex
+	messages  []Message
+	logBodies bool
+}
+
+func NewMemorySender(logBodies bool) *MemorySender {
+	return &MemorySender{logBodies: logBodies}
+}
+
+func (s *MemorySender) Send(phoneNumber, message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.messages) == maxStoredMessages {
+		s.messages = append(s.messages[:0], s.messages[1:]...)
+	}
+	s.messages = append(s.messages, Message{
+		PhoneNumber: phoneNumber,
+		Body:        message,
+		SentAt:      time.Now(),
+	})
+
+	if s.logBodies {
+		slog.Info("SMS stored in memory",
+			slog.String("phone_number", phoneNumber),
+			slog.String("body", message))
+	} else {
+		slog.Info("SMS stored in memory", slog.String("phone_number", phoneNumber))
+	}
+
+	return nil
+}
+
+func (s *MemorySender) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages := make([]Message, len(s.messages))
+	copy(messages, s.messages)
+	return messages
+}
+
+func (s *MemorySender) LastMessageTo(phoneNumber string) (Message, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if s.messages[i].PhoneNumber == phoneNumber {
+			return s.messages[i], true
+		}
+	}
+	return Message{}, false
+}
@@ -0,0 +1,57 @@
+package sms
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/core/ports/sms"
+)
+
+const twilioMessagesEndpoint = "https://api.twilio.com/2010-04-01/Accounts/%s/Messages.json"
+
+type twilioSender struct {
+	accountSID string
+	authToken  string
+	fromNumber string
+	client     *http.Client
+}
+
+func NewTwilioSender(accountSID, authToken, fromNumber string) sms.Sender {
+	return &twilioSender{
+		accountSID: accountSID,
+		authToken:  authToken,
+		fromNumber: fromNumber,
+		client:     &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *twilioSender) Send(phoneNumber, message string) error {
+	form := url.Values{
+		"To":   {phoneNumber},
+		"From": {s.fromNumber},
+		"Body": {message},
+	}
+
+	endpoint := fmt.Sprintf(twilioMessagesEndpoint, url.PathEscape(s.accountSID))
+	request, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	request.SetBasicAuth(s.accountSID, s.authToken)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("error sending sms with twilio: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("twilio responded with status %d", response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/EstebanGitPro/motogo-backend/core/ports/storage"
+)
+
+// localStorage is used in development. It writes objects under dir and the
+// API serves that directory at publicBaseURL.
+type localStorage struct {
+	dir           string
+	publicBaseURL string
+}
+
+func NewLocalStorage(dir, publicBaseURL string) storage.ObjectStorage {
+	return &localStorage{
+		dir:           dir,
+		publicBaseURL: strings.TrimSuffix(publicBaseURL, "/"),
+	}
+}
+
+func (s *localStorage) Put(object storage.Object) (string, error) {
+	path, err := s.path(object.Key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("error creating storage directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, object.Data, 0o644); err != nil {
+		return "", fmt.Errorf("error writing object %s: %w", object.Key, err)
+	}
+
+	return s.publicBaseURL + "/" + object.Key, nil
+}
+
+func (s *localStorage) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error deleting object %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *localStorage) path(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/storage"
+)
+
+const (
+	s3Service     = "s3"
+	s3Algorithm   = "AWS4-HMAC-SHA256"
+	amzDateFormat = "20060102T150405Z"
+	// Keys are never reused, so clients and CDNs may cache objects forever.
+	objectCacheControl = "public, max-age=31536000, immutable"
+)
+
+// s3Storage talks to any S3 compatible API with plain HTTP requests signed
+// with AWS Signature Version 4.
+type s3Storage struct {
+	endpoint      *url.URL
+	region        string
+	bucket        string
+	accessKeyID   string
+	secretKey     string
+	usePathStyle  bool
+	publicBaseURL string
+	client        *http.Client
+}
+
+func NewS3Storage(cfg config.S3, publicBaseURL string) (storage.ObjectStorage, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.Endpoint)
+	}
+
+	s := &s3Storage{
+		endpoint:     endpoint,
+		region:       cfg.Region,
+		bucket:       cfg.Bucket,
+		accessKeyID:  cfg.AccessKeyID,
+		secretKey:    cfg.SecretAccessKey,
+		usePathStyle: cfg.UsePathStyle,
+		client:       &http.Client{Timeout: 30 * time.Second},
+	}
+
+	s.publicBaseURL = strings.TrimSuffix(publicBaseURL, "/")
+	if s.publicBaseURL == "" {
+		s.publicBaseURL = strings.TrimSuffix(s.objectURL(""), "/")
+	}
+
+	return s, nil
+}
+
+func (s *s3Storage) Put(object storage.Object) (string, error) {
+	request, err := http.NewRequest(http.MethodPut, s.objectURL(object.Key), bytes.NewReader(object.Data))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", object.ContentType)
+	request.Header.Set("Cache-Control", objectCacheControl)
+	s.sign(request, object.Data, time.Now())
+
+	if err := s.do(request); err != nil {
+		return "", fmt.Errorf("error uploading object %s: %w", object.Key, err)
+	}
+
+	return s.publicBaseURL + "/" + object.Key, nil
+}
+
+func (s *s3Storage) Delete(key string) error {
+	request, err := http.NewRequest(http.MethodDelete, s.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+	s.sign(request, nil, time.Now())
+
+	// S3 answers 204 whether or not the key existed.
+	if err := s.do(request); err != nil {
+		return fmt.Errorf("error deleting object %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *s3Storage) do(request *http.Request) error {
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("s3 responded with status %d", response.StatusCode)
+	}
+	return nil
+}
+
+func (s *s3Storage) objectURL(key string) string {
+	u := *s.endpoint
+	path := strings.TrimSuffix(u.Path, "/")
+	if s.usePathStyle {
+		path += "/" + s.bucket
+	} else {
+		u.Host = s.bucket + "." + u.Host
+	}
+	u.Path = path + "/" + key
+	u.RawPath = path + "/" + escapePath(key)
+	return u.String()
+}
+
+// sign adds the AWS Signature Version 4 headers to the request.
+func (s *s3Storage) sign(request *http.Request, payload []byte, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(amzDateFormat)
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+
+	request.Header.Set("X-Amz-Date", amzDate)
+	request.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + request.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		request.Method,
+		request.URL.EscapedPath(),
+		request.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/" + s3Service + "/aws4_request"
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	request.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.accessKeyID, scope, signedHeaders, signature))
+}
+
+// escapePath encodes every byte of the key that SigV4 does not leave as is,
+// keeping the slashes between segments.
+func escapePath(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(url.QueryEscape(segment), "+", "%20")
+	}
+	return strings.Join(segments, "/")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
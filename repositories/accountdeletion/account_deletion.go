@@ -0,0 +1,32 @@
+package accountdeletion
+
+import (
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type AccountDeletion struct {
+	PersonID     string     `db:"person_id"`
+	RequestedAt  time.Time  `db:"requested_at"`
+	ScheduledFor time.Time  `db:"scheduled_for"`
+	CompletedAt  *time.Time `db:"completed_at"`
+}
+
+func (d AccountDeletion) ToDomain() domain.AccountDeletion {
+	return domain.AccountDeletion{
+		PersonID:     d.PersonID,
+		RequestedAt:  d.RequestedAt,
+		ScheduledFor: d.ScheduledFor,
+		CompletedAt:  d.CompletedAt,
+	}
+}
+
+func FromDomain(d domain.AccountDeletion) AccountDeletion {
+	return AccountDeletion{
+		PersonID:     d.PersonID,
+		RequestedAt:  d.RequestedAt,
+		ScheduledFor: d.ScheduledFor,
+		CompletedAt:  d.CompletedAt,
+	}
+}
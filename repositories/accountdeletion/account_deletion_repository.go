@@ -0,0 +1,165 @@
+package accountdeletion
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) ports.AccountDeletionRepository {
+	return &repository{
+		db: db,
+	}
+}
+
+const (
+	deletionColumns = "person_id, requested_at, scheduled_for, completed_at"
+
+	querySave     = "INSERT INTO account_deletions (" + deletionColumns + ") VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE requested_at = VALUES(requested_at), scheduled_for = VALUES(scheduled_for), completed_at = VALUES(completed_at)"
+	queryGet      = "SELECT " + deletionColumns + " FROM account_deletions WHERE person_id = ?"
+	queryCancel   = "DELETE FROM account_deletions WHERE person_id = ? AND completed_at IS NULL"
+	queryListDue  = "SELECT " + deletionColumns + " FROM account_deletions WHERE completed_at IS NULL AND scheduled_for <= ? ORDER BY scheduled_for LIMIT ?"
+	queryComplete = "UPDATE account_deletions SET completed_at = ? WHERE person_id = ? AND completed_at IS NULL"
+
+	queryAnonymizePerson = "UPDATE persons SET document_type = NULL, identity_number = NULL, first_name = ?, last_name = ?, second_last_name = '', email = ?, email_canonical = ?, phone_number = NULL, country = '', email_verified = FALSE, phone_number_verified = FALSE, password = '', photo_key = '', photo_url = '', photo_thumbnail_url = '', deleted_at = ?, updated_at = ?, version = version + 1 WHERE id = ?"
+	queryRevokeAPIKeys   = "UPDATE api_keys SET revoked_at = ? WHERE created_by = ? AND revoked_at IS NULL"
+	queryDeleteFailures  = "DELETE FROM login_failures WHERE attempt_key = ?"
+	queryDeleteLockouts  = "DELETE FROM login_lockouts WHERE attempt_key = ?"
+)
+
+// personDataQueries delete the rows that exist only because of the person
+// and still identify them: credentials, devices and linked identities.
+var personDataQueries = []string{
+	"DELETE FROM refresh_tokens WHERE person_id = ?",
+	"DELETE FROM sessions WHERE person_id = ?",
+	"DELETE FROM verification_tokens WHERE person_id = ?",
+	"DELETE FROM phone_otps WHERE person_id = ?",
+	"DELETE FROM mfa_recovery_codes WHERE person_id = ?",
+	"DELETE FROM mfa_totp_factors WHERE person_id = ?",
+	"DELETE FROM external_identities WHERE person_id = ?",
+}
+
+func (r *repository) Save(deletion domain.AccountDeletion) error {
+	d := FromDomain(deletion)
+
+	if _, err := r.db.Exec(querySave, d.PersonID, d.RequestedAt, d.ScheduledFor, d.CompletedAt); err != nil {
+		return domain.ErrAccountDeletionCannotSave
+	}
+	return nil
+}
+
+func (r *repository) Get(personID string) (*domain.AccountDeletion, error) {
+	d, err := scanDeletion(r.db.QueryRow(queryGet, personID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrAccountDeletionNotFound
+		}
+		return nil, domain.ErrAccountDeletionCannotGet
+	}
+	deletion := d.ToDomain()
+	return &deletion, nil
+}
+
+func (r *repository) Cancel(personID string) (bool, error) {
+	result, err := r.db.Exec(queryCancel, personID)
+	if err != nil {
+		return false, domain.ErrAccountDeletionCannotSave
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, domain.ErrAccountDeletionCannotSave
+	}
+	return affected > 0, nil
+}
+
+func (r *repository) ListDue(at time.Time, limit int) ([]domain.AccountDeletion, error) {
+	rows, err := r.db.Query(queryListDue, at, limit)
+	if err != nil {
+		return nil, domain.ErrAccountDeletionCannotGet
+	}
+	defer rows.Close()
+
+	deletions := []domain.AccountDeletion{}
+	for rows.Next() {
+		d, err := scanDeletion(rows)
+		if err != nil {
+			return nil, domain.ErrAccountDeletionCannotGet
+		}
+		deletions = append(deletions, d.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.ErrAccountDeletionCannotGet
+	}
+
+	return deletions, nil
+}
+
+func (r *repository) Anonymize(person domain.Person, at time.Time) error {
+	anonymized := domain.AnonymizedPerson(person)
+	emailKey := domain.LoginAttemptEmailKey(person.Email)
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return domain.ErrAccountDeletionCannotSave
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(queryAnonymizePerson,
+		anonymized.FirstName,
+		anonymized.LastName,
+		anonymized.Email,
+		domain.CanonicalEmail(anonymized.Email),
+		at,
+		at,
+		person.ID,
+	)
+	if err != nil {
+		return domain.ErrAccountDeletionCannotSave
+	}
+
+	for _, query := range personDataQueries {
+		if _, err := tx.Exec(query, person.ID); err != nil {
+			return domain.ErrAccountDeletionCannotSave
+		}
+	}
+
+	if _, err := tx.Exec(queryRevokeAPIKeys, at, person.ID); err != nil {
+		return domain.ErrAccountDeletionCannotSave
+	}
+	if _, err := tx.Exec(queryDeleteFailures, emailKey); err != nil {
+		return domain.ErrAccountDeletionCannotSave
+	}
+	if _, err := tx.Exec(queryDeleteLockouts, emailKey); err != nil {
+		return domain.ErrAccountDeletionCannotSave
+	}
+	if _, err := tx.Exec(queryComplete, at, person.ID); err != nil {
+		return domain.ErrAccountDeletionCannotSave
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.ErrAccountDeletionCannotSave
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDeletion(row rowScanner) (AccountDeletion, error) {
+	var d AccountDeletion
+	err := row.Scan(
+		&d.PersonID,
+		&d.RequestedAt,
+		&d.ScheduledFor,
+		&d.CompletedAt,
+	)
+	return d, err
+}
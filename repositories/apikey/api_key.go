@@ -0,0 +1,74 @@
+package apikey
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+const scopeSeparator = ","
+
+type APIKey struct {
+	ID         string       `db:"id"`
+	Name       string       `db:"name"`
+	Prefix     string       `db:"prefix"`
+	KeyHash    string       `db:"key_hash"`
+	Scopes     string       `db:"scopes"`
+	CreatedBy  string       `db:"created_by"`
+	CreatedAt  time.Time    `db:"created_at"`
+	ExpiresAt  sql.NullTime `db:"expires_at"`
+	RevokedAt  sql.NullTime `db:"revoked_at"`
+	LastUsedAt sql.NullTime `db:"last_used_at"`
+}
+
+func (k APIKey) ToDomain() domain.APIKey {
+	key := domain.APIKey{
+		ID:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		KeyHash:   k.KeyHash,
+		Scopes:    []string{},
+		CreatedBy: k.CreatedBy,
+		CreatedAt: k.CreatedAt,
+	}
+	if k.Scopes != "" {
+		key.Scopes = strings.Split(k.Scopes, scopeSeparator)
+	}
+	if k.ExpiresAt.Valid {
+		expiresAt := k.ExpiresAt.Time
+		key.ExpiresAt = &expiresAt
+	}
+	if k.RevokedAt.Valid {
+		revokedAt := k.RevokedAt.Time
+		key.RevokedAt = &revokedAt
+	}
+	if k.LastUsedAt.Valid {
+		lastUsedAt := k.LastUsedAt.Time
+		key.LastUsedAt = &lastUsedAt
+	}
+	return key
+}
+
+func FromDomain(k domain.APIKey) APIKey {
+	key := APIKey{
+		ID:        k.ID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		KeyHash:   k.KeyHash,
+		Scopes:    strings.Join(k.Scopes, scopeSeparator),
+		CreatedBy: k.CreatedBy,
+		CreatedAt: k.CreatedAt,
+	}
+	if k.ExpiresAt != nil {
+		key.ExpiresAt = sql.NullTime{Time: *k.ExpiresAt, Valid: true}
+	}
+	if k.RevokedAt != nil {
+		key.RevokedAt = sql.NullTime{Time: *k.RevokedAt, Valid: true}
+	}
+	if k.LastUsedAt != nil {
+		key.LastUsedAt = sql.NullTime{Time: *k.LastUsedAt, Valid: true}
+	}
+	return key
+}
@@ -0,0 +1,129 @@
+package apikey
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) ports.APIKeyRepository {
+	return &repository{
+		db: db,
+	}
+}
+
+const (
+	apiKeyColumns = "id, name, prefix, key_hash, scopes, created_by, created_at, expires_at, revoked_at, last_used_at"
+
+	querySave          = "INSERT INTO api_keys (" + apiKeyColumns + ") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	queryGetByHash     = "SELECT " + apiKeyColumns + " FROM api_keys WHERE key_hash = ?"
+	queryGetByID       = "SELECT " + apiKeyColumns + " FROM api_keys WHERE id = ?"
+	queryList          = "SELECT " + apiKeyColumns + " FROM api_keys ORDER BY created_at DESC"
+	queryRevoke        = "UPDATE api_keys SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL"
+	queryTouchLastUsed = "UPDATE api_keys SET last_used_at = ? WHERE id = ?"
+)
+
+func (r *repository) Save(key domain.APIKey) error {
+	keyToSave := FromDomain(key)
+
+	_, err := r.db.Exec(querySave,
+		keyToSave.ID,
+		keyToSave.Name,
+		keyToSave.Prefix,
+		keyToSave.KeyHash,
+		keyToSave.Scopes,
+		keyToSave.CreatedBy,
+		keyToSave.CreatedAt,
+		keyToSave.ExpiresAt,
+		keyToSave.RevokedAt,
+		keyToSave.LastUsedAt,
+	)
+	if err != nil {
+		return domain.ErrAPIKeyCannotSave
+	}
+
+	return nil
+}
+
+func (r *repository) GetByHash(keyHash string) (*domain.APIKey, error) {
+	return r.getOne(queryGetByHash, keyHash)
+}
+
+func (r *repository) GetByID(id string) (*domain.APIKey, error) {
+	return r.getOne(queryGetByID, id)
+}
+
+func (r *repository) List() ([]domain.APIKey, error) {
+	rows, err := r.db.Query(queryList)
+	if err != nil {
+		return nil, domain.ErrAPIKeyCannotGet
+	}
+	defer rows.Close()
+
+	keys := []domain.APIKey{}
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, domain.ErrAPIKeyCannotGet
+		}
+		keys = append(keys, k.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.ErrAPIKeyCannotGet
+	}
+
+	return keys, nil
+}
+
+func (r *repository) Revoke(id string, at time.Time) error {
+	if _, err := r.db.Exec(queryRevoke, at, id); err != nil {
+		return domain.ErrAPIKeyCannotSave
+	}
+	return nil
+}
+
+func (r *repository) TouchLastUsed(id string, at time.Time) error {
+	if _, err := r.db.Exec(queryTouchLastUsed, at, id); err != nil {
+		return domain.ErrAPIKeyCannotSave
+	}
+	return nil
+}
+
+func (r *repository) getOne(query string, arg string) (*domain.APIKey, error) {
+	k, err := scanAPIKey(r.db.QueryRow(query, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+		return nil, domain.ErrAPIKeyCannotGet
+	}
+	d := k.ToDomain()
+	return &d, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAPIKey(row rowScanner) (APIKey, error) {
+	var k APIKey
+	err := row.Scan(
+		&k.ID,
+		&k.Name,
+		&k.Prefix,
+		&k.KeyHash,
+		&k.Scopes,
+		&k.CreatedBy,
+		&k.CreatedAt,
+		&k.ExpiresAt,
+		&k.RevokedAt,
+		&k.LastUsedAt,
+	)
+	return k, err
+}
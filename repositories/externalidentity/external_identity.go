@@ -0,0 +1,55 @@
+package externalidentity
+
+import (
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type ExternalIdentity struct {
+	Provider  string    `db:"provider"`
+	Subject   string    `db:"subject"`
+	PersonID  string    `db:"person_id"`
+	Email     string    `db:"email"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (e ExternalIdentity) ToDomain() domain.ExternalIdentity {
+	return domain.ExternalIdentity{
+		Provider:  e.Provider,
+		Subject:   e.Subject,
+		PersonID:  e.PersonID,
+		Email:     e.Email,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func FromDomain(e domain.ExternalIdentity) ExternalIdentity {
+	return ExternalIdentity{
+		Provider:  e.Provider,
+		Subject:   e.Subject,
+		PersonID:  e.PersonID,
+		Email:     e.Email,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+type LoginState struct {
+	State        string    `db:"state"`
+	Provider     string    `db:"provider"`
+	Nonce        string    `db:"nonce"`
+	CodeVerifier string    `db:"code_verifier"`
+	ExpiresAt    time.Time `db:"expires_at"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+func (s LoginState) ToDomain() domain.OIDCLoginState {
+	return domain.OIDCLoginState{
+		State:        s.State,
+		Provider:     s.Provider,
+		Nonce:        s.Nonce,
+		CodeVerifier: s.CodeVerifier,
+		ExpiresAt:    s.ExpiresAt,
+		CreatedAt:    s.CreatedAt,
+	}
+}
@@ -0,0 +1,148 @@
+package externalidentity
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	mysql "github.com/go-sql-driver/mysql"
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) ports.ExternalIdentityRepository {
+	return &repository{
+		db: db,
+	}
+}
+
+const (
+	querySave                     = "INSERT INTO external_identities (provider, subject, person_id, email, created_at) VALUES (?, ?, ?, ?, ?)"
+	queryGet                      = "SELECT provider, subject, person_id, email, created_at FROM external_identities WHERE provider = ? AND subject = ?"
+	queryListByPerson             = "SELECT provider, subject, person_id, email, created_at FROM external_identities WHERE person_id = ? ORDER BY created_at"
+	querySaveLoginState           = "INSERT INTO oidc_login_states (state, provider, nonce, code_verifier, expires_at, created_at) VALUES (?, ?, ?, ?, ?, ?)"
+	queryGetLoginStateForUpdate   = "SELECT state, provider, nonce, code_verifier, expires_at, created_at FROM oidc_login_states WHERE state = ? FOR UPDATE"
+	queryDeleteLoginState         = "DELETE FROM oidc_login_states WHERE state = ?"
+	queryDeleteExpiredLoginStates = "DELETE FROM oidc_login_states WHERE expires_at < ?"
+)
+
+func (r *repository) Save(identity domain.ExternalIdentity) error {
+	identityToSave := FromDomain(identity)
+
+	_, err := r.db.Exec(querySave,
+		identityToSave.Provider,
+		identityToSave.Subject,
+		identityToSave.PersonID,
+		identityToSave.Email,
+		identityToSave.CreatedAt,
+	)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+			return domain.ErrDuplicateUser
+		}
+		return domain.ErrExternalIdentityCannotSave
+	}
+
+	return nil
+}
+
+func (r *repository) Get(provider, subject string) (*domain.ExternalIdentity, error) {
+	var e ExternalIdentity
+	err := r.db.QueryRow(queryGet, provider, subject).Scan(
+		&e.Provider,
+		&e.Subject,
+		&e.PersonID,
+		&e.Email,
+		&e.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrExternalIdentityNotFound
+		}
+		return nil, domain.ErrExternalIdentityCannotGet
+	}
+	d := e.ToDomain()
+	return &d, nil
+}
+
+func (r *repository) ListByPerson(personID string) ([]domain.ExternalIdentity, error) {
+	rows, err := r.db.Query(queryListByPerson, personID)
+	if err != nil {
+		return nil, domain.ErrExternalIdentityCannotGet
+	}
+	defer rows.Close()
+
+	identities := []domain.ExternalIdentity{}
+	for rows.Next() {
+		var e ExternalIdentity
+		if err := rows.Scan(&e.Provider, &e.Subject, &e.PersonID, &e.Email, &e.CreatedAt); err != nil {
+			return nil, domain.ErrExternalIdentityCannotGet
+		}
+		identities = append(identities, e.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.ErrExternalIdentityCannotGet
+	}
+
+	return identities, nil
+}
+
+func (r *repository) SaveLoginState(state domain.OIDCLoginState) error {
+	_, err := r.db.Exec(querySaveLoginState,
+		state.State,
+		state.Provider,
+		state.Nonce,
+		state.CodeVerifier,
+		state.ExpiresAt,
+		state.CreatedAt,
+	)
+	if err != nil {
+		return domain.ErrExternalIdentityCannotSave
+	}
+	return nil
+}
+
+func (r *repository) ConsumeLoginState(state string) (*domain.OIDCLoginState, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, domain.ErrExternalIdentityCannotGet
+	}
+	defer tx.Rollback()
+
+	var s LoginState
+	err = tx.QueryRow(queryGetLoginStateForUpdate, state).Scan(
+		&s.State,
+		&s.Provider,
+		&s.Nonce,
+		&s.CodeVerifier,
+		&s.ExpiresAt,
+		&s.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrOIDCInvalidState
+		}
+		return nil, domain.ErrExternalIdentityCannotGet
+	}
+
+	if _, err := tx.Exec(queryDeleteLoginState, state); err != nil {
+		return nil, domain.ErrExternalIdentityCannotSave
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, domain.ErrExternalIdentityCannotSave
+	}
+
+	d := s.ToDomain()
+	return &d, nil
+}
+
+func (r *repository) DeleteExpiredLoginStates(before time.Time) error {
+	if _, err := r.db.Exec(queryDeleteExpiredLoginStates, before); err != nil {
+		return domain.ErrExternalIdentityCannotSave
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package loginattempt
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+)
+
+type repository struct {
+	db        *sql.DB
+	retention time.Duration
+}
+
+// NewRepository stores attempts in MySQL so that every instance behind the
+// load balancer sees the same counters. Failures older than retention are
+// deleted as new ones are recorded.
+func NewRepository(db *sql.DB, retention time.Duration) ports.LoginAttemptStore {
+	return &repository{
+		db:        db,
+		retention: retention,
+	}
+}
+
+const (
+	queryRecordFailure = "INSERT INTO login_failures (attempt_key, failed_at) VALUES (?, ?)"
+	queryPruneFailures = "DELETE FROM login_failures WHERE attempt_key = ? AND failed_at < ?"
+	queryFailures      = "SELECT failed_at FROM login_failures WHERE attempt_key = ? AND failed_at >= ? ORDER BY failed_at"
+	queryLock          = "INSERT INTO login_lockouts (attempt_key, locked_until) VALUES (?, ?) ON DUPLICATE KEY UPDATE locked_until = VALUES(locked_until)"
+	queryLockedUntil   = "SELECT locked_until FROM login_lockouts WHERE attempt_key = ? AND locked_until > ? LIMIT 1"
+	queryClearFailures = "DELETE FROM login_failures WHERE attempt_key = ?"
+	queryClearLockout  = "DELETE FROM login_lockouts WHERE attempt_key = ?"
+)
+
+func (r *repository) RecordFailure(key string, at time.Time) error {
+	if _, err := r.db.Exec(queryRecordFailure, key, at); err != nil {
+		return domain.ErrLoginAttemptsCannotSave
+	}
+	if _, err := r.db.Exec(queryPruneFailures, key, at.Add(-r.retention)); err != nil {
+		return domain.ErrLoginAttemptsCannotSave
+	}
+	return nil
+}
+
+func (r *repository) Failures(key string, since time.Time) ([]time.Time, error) {
+	rows, err := r.db.Query(queryFailures, key, since)
+	if err != nil {
+		return nil, domain.ErrLoginAttemptsCannotGet
+	}
+	defer rows.Close()
+
+	var failures []time.Time
+	for rows.Next() {
+		var failedAt time.Time
+		if err := rows.Scan(&failedAt); err != nil {
+			return nil, domain.ErrLoginAttemptsCannotGet
+		}
+		failures = append(failures, failedAt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.ErrLoginAttemptsCannotGet
+	}
+
+	return failures, nil
+}
+
+func (r *repository) Lock(key string, until time.Time) error {
+	if _, err := r.db.Exec(queryLock, key, until); err != nil {
+		return domain.ErrLoginAttemptsCannotSave
+	}
+	return nil
+}
+
+func (r *repository) LockedUntil(key string) (time.Time, error) {
+	var lockedUntil time.Time
+	err := r.db.QueryRow(queryLockedUntil, key, time.Now()).Scan(&lockedUntil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, nil
+		}
+		return time.Time{}, domain.ErrLoginAttemptsCannotGet
+	}
+	return lockedUntil, nil
+}
+
+func (r *repository) Clear(key string) error {
+	if _, err := r.db.Exec(queryClearFailures, key); err != nil {
+		return domain.ErrLoginAttemptsCannotSave
+	}
+	if _, err := r.db.Exec(queryClearLockout, key); err != nil {
+		return domain.ErrLoginAttemptsCannotSave
+	}
+	return nil
+}
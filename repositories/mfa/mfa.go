@@ -0,0 +1,56 @@
+package mfa
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+// Factor stores the TOTP secret encrypted; the repository seals and opens it.
+type Factor struct {
+	PersonID     string       `db:"person_id"`
+	Secret       string       `db:"secret_ciphertext"`
+	LastUsedStep int64        `db:"last_used_step"`
+	CreatedAt    time.Time    `db:"created_at"`
+	ConfirmedAt  sql.NullTime `db:"confirmed_at"`
+}
+
+func (f Factor) ToDomain() domain.MFAFactor {
+	factor := domain.MFAFactor{
+		PersonID:     f.PersonID,
+		Secret:       f.Secret,
+		LastUsedStep: f.LastUsedStep,
+		CreatedAt:    f.CreatedAt,
+	}
+	if f.ConfirmedAt.Valid {
+		confirmedAt := f.ConfirmedAt.Time
+		factor.ConfirmedAt = &confirmedAt
+	}
+	return factor
+}
+
+func FromDomain(f domain.MFAFactor) Factor {
+	factor := Factor{
+		PersonID:     f.PersonID,
+		Secret:       f.Secret,
+		LastUsedStep: f.LastUsedStep,
+		CreatedAt:    f.CreatedAt,
+	}
+	if f.ConfirmedAt != nil {
+		factor.ConfirmedAt = sql.NullTime{Time: *f.ConfirmedAt, Valid: true}
+	}
+	return factor
+}
+
+type RolePolicy struct {
+	Role     string `db:"role"`
+	Required bool   `db:"required"`
+}
+
+func (p RolePolicy) ToDomain() domain.MFARolePolicy {
+	return domain.MFARolePolicy{
+		Role:     p.Role,
+		Required: p.Required,
+	}
+}
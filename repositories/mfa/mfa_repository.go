@@ -0,0 +1,191 @@
+package mfa
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/platform/secretbox"
+)
+
+type repository struct {
+	db  *sql.DB
+	box *secretbox.SecretBox
+}
+
+func NewRepository(db *sql.DB, box *secretbox.SecretBox) ports.MFARepository {
+	return &repository{
+		db:  db,
+		box: box,
+	}
+}
+
+const (
+	querySaveFactor          = "INSERT INTO mfa_totp_factors (person_id, secret_ciphertext, last_used_step, created_at, confirmed_at) VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE secret_ciphertext = VALUES(secret_ciphertext), last_used_step = VALUES(last_used_step), created_at = VALUES(created_at), confirmed_at = VALUES(confirmed_at)"
+	queryGetFactor           = "SELECT person_id, secret_ciphertext, last_used_step, created_at, confirmed_at FROM mfa_totp_factors WHERE person_id = ?"
+	queryConfirmFactor       = "UPDATE mfa_totp_factors SET confirmed_at = ? WHERE person_id = ?"
+	queryUpdateLastUsedStep  = "UPDATE mfa_totp_factors SET last_used_step = ? WHERE person_id = ? AND last_used_step < ?"
+	queryDeleteFactor        = "DELETE FROM mfa_totp_factors WHERE person_id = ?"
+	queryDeleteRecoveryCodes = "DELETE FROM mfa_recovery_codes WHERE person_id = ?"
+	querySaveRecoveryCode    = "INSERT INTO mfa_recovery_codes (id, person_id, code_hash, created_at, used_at) VALUES (?, ?, ?, ?, NULL)"
+	queryUseRecoveryCode     = "UPDATE mfa_recovery_codes SET used_at = ? WHERE person_id = ? AND code_hash = ? AND used_at IS NULL"
+	queryGetRolePolicies     = "SELECT role, required FROM mfa_role_policies ORDER BY role"
+	querySetRolePolicy       = "INSERT INTO mfa_role_policies (role, required) VALUES (?, ?) ON DUPLICATE KEY UPDATE required = VALUES(required)"
+)
+
+func (r *repository) SaveFactor(factor domain.MFAFactor) error {
+	sealed, err := r.box.Seal(factor.Secret)
+	if err != nil {
+		return domain.ErrMFACannotSave
+	}
+
+	factorToSave := FromDomain(factor)
+	factorToSave.Secret = sealed
+
+	_, err = r.db.Exec(querySaveFactor,
+		factorToSave.PersonID,
+		factorToSave.Secret,
+		factorToSave.LastUsedStep,
+		factorToSave.CreatedAt,
+		factorToSave.ConfirmedAt,
+	)
+	if err != nil {
+		return domain.ErrMFACannotSave
+	}
+
+	return nil
+}
+
+func (r *repository) GetFactor(personID string) (*domain.MFAFactor, error) {
+	var f Factor
+	err := r.db.QueryRow(queryGetFactor, personID).Scan(
+		&f.PersonID,
+		&f.Secret,
+		&f.LastUsedStep,
+		&f.CreatedAt,
+		&f.ConfirmedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrMFAFactorNotFound
+		}
+		return nil, domain.ErrMFACannotGet
+	}
+
+	secret, err := r.box.Open(f.Secret)
+	if err != nil {
+		return nil, domain.ErrMFACannotGet
+	}
+	f.Secret = secret
+
+	d := f.ToDomain()
+	return &d, nil
+}
+
+func (r *repository) ConfirmFactor(personID string, confirmedAt time.Time) error {
+	if _, err := r.db.Exec(queryConfirmFactor, confirmedAt, personID); err != nil {
+		return domain.ErrMFACannotSave
+	}
+	return nil
+}
+
+func (r *repository) UpdateLastUsedStep(personID string, step int64) (bool, error) {
+	result, err := r.db.Exec(queryUpdateLastUsedStep, step, personID, step)
+	if err != nil {
+		return false, domain.ErrMFACannotSave
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, domain.ErrMFACannotSave
+	}
+
+	return affected == 1, nil
+}
+
+func (r *repository) DeleteFactor(personID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return domain.ErrMFACannotSave
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(queryDeleteFactor, personID); err != nil {
+		return domain.ErrMFACannotSave
+	}
+	if _, err := tx.Exec(queryDeleteRecoveryCodes, personID); err != nil {
+		return domain.ErrMFACannotSave
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.ErrMFACannotSave
+	}
+	return nil
+}
+
+func (r *repository) ReplaceRecoveryCodes(personID string, codes []domain.MFARecoveryCode) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return domain.ErrMFACannotSave
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(queryDeleteRecoveryCodes, personID); err != nil {
+		return domain.ErrMFACannotSave
+	}
+
+	for _, code := range codes {
+		if _, err := tx.Exec(querySaveRecoveryCode, code.ID, personID, code.CodeHash, code.CreatedAt); err != nil {
+			return domain.ErrMFACannotSave
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return domain.ErrMFACannotSave
+	}
+	return nil
+}
+
+func (r *repository) UseRecoveryCode(personID, codeHash string, usedAt time.Time) (bool, error) {
+	result, err := r.db.Exec(queryUseRecoveryCode, usedAt, personID, codeHash)
+	if err != nil {
+		return false, domain.ErrMFACannotSave
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, domain.ErrMFACannotSave
+	}
+
+	return affected == 1, nil
+}
+
+func (r *repository) GetRolePolicies() ([]domain.MFARolePolicy, error) {
+	rows, err := r.db.Query(queryGetRolePolicies)
+	if err != nil {
+		return nil, domain.ErrMFACannotGet
+	}
+	defer rows.Close()
+
+	var policies []domain.MFARolePolicy
+	for rows.Next() {
+		var p RolePolicy
+		if err := rows.Scan(&p.Role, &p.Required); err != nil {
+			return nil, domain.ErrMFACannotGet
+		}
+		policies = append(policies, p.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.ErrMFACannotGet
+	}
+
+	return policies, nil
+}
+
+func (r *repository) SetRolePolicy(policy domain.MFARolePolicy) error {
+	if _, err := r.db.Exec(querySetRolePolicy, policy.Role, policy.Required); err != nil {
+		return domain.ErrMFACannotSave
+	}
+	return nil
+}
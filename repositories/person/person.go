@@ -2,53 +2,102 @@ package person
 
 
 import (
+	"database/sql"
+	"time"
+
 	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
 )
 
 
 type Person struct {
-	ID                  string `db:"id"`
-	IdentityNumber      string `db:"identity_number"`
-	FirstName           string `db:"first_name"`
-	LastName            string `db:"last_name"`
-	SecondLastName      string `db:"second_last_name"`
-	Email               string `db:"email"`
-	PhoneNumber         string `db:"phone_number"`
-	EmailVerified       bool   `db:"email_verified"`
-	PhoneNumberVerified bool   `db:"phone_number_verified"`
-	Password            string `db:"password"`
-	Role                string `db:"role"`
+	ID                  string         `db:"id"`
+	DocumentType        sql.NullString `db:"document_type"`
+	IdentityNumber      sql.NullString `db:"identity_number"`
+	FirstName           string         `db:"first_name"`
+	LastName            string         `db:"last_name"`
+	SecondLastName      string         `db:"second_last_name"`
+	Email               string         `db:"email"`
+	PhoneNumber         sql.NullString `db:"phone_number"`
+	Country             string         `db:"country"`
+	EmailVerified       bool           `db:"email_verified"`
+	PhoneNumberVerified bool           `db:"phone_number_verified"`
+	Password            string         `db:"password"`
+	Role                string         `db:"role"`
+	Status              string         `db:"status"`
+	StatusReason        string         `db:"status_reason"`
+	StatusExpiresAt     *time.Time     `db:"status_expires_at"`
+	StatusSetByRole     string         `db:"status_set_by_role"`
+	PhotoKey            string         `db:"photo_key"`
+	PhotoURL            string         `db:"photo_url"`
+	PhotoThumbnailURL   string         `db:"photo_thumbnail_url"`
+	CreatedAt           time.Time      `db:"created_at"`
+	UpdatedAt           time.Time      `db:"updated_at"`
+	DeletedAt           *time.Time     `db:"deleted_at"`
+	Version             int64          `db:"version"`
 }
 
 
 func (p Person) ToDomain() domain.Person {
 	return domain.Person{
 		ID:                  p.ID,
-		IdentityNumber:      p.IdentityNumber,
+		DocumentType:        p.DocumentType.String,
+		IdentityNumber:      p.IdentityNumber.String,
 		FirstName:           p.FirstName,
 		LastName:            p.LastName,
 		SecondLastName:      p.SecondLastName,
 		Email:               p.Email,
-		PhoneNumber:         p.PhoneNumber,
+		PhoneNumber:         p.PhoneNumber.String,
+		Country:             p.Country,
 		EmailVerified:       p.EmailVerified,
 		PhoneNumberVerified: p.PhoneNumberVerified,
 		Password:            p.Password,
 		Role:                p.Role,
+		Status:              p.Status,
+		StatusReason:        p.StatusReason,
+		StatusExpiresAt:     p.StatusExpiresAt,
+		StatusSetByRole:     p.StatusSetByRole,
+		PhotoKey:            p.PhotoKey,
+		PhotoURL:            p.PhotoURL,
+		PhotoThumbnailURL:   p.PhotoThumbnailURL,
+		CreatedAt:           p.CreatedAt,
+		UpdatedAt:           p.UpdatedAt,
+		DeletedAt:           p.DeletedAt,
+		Version:             p.Version,
 	}
 }
 
 func FromDomain(p domain.Person) Person {
 	return Person{
 		ID:                  p.ID,
-		IdentityNumber:      p.IdentityNumber,
+		DocumentType:        nullableString(p.DocumentType),
+		IdentityNumber:      nullableString(p.IdentityNumber),
 		FirstName:           p.FirstName,
 		LastName:            p.LastName,
 		SecondLastName:      p.SecondLastName,
 		Email:               p.Email,
-		PhoneNumber:         p.PhoneNumber,
+		PhoneNumber:         nullableString(p.PhoneNumber),
+		Country:             p.Country,
 		EmailVerified:       p.EmailVerified,
 		PhoneNumberVerified: p.PhoneNumberVerified,
 		Password:            p.Password,
 		Role:                p.Role,
+		Status:              p.Status,
+		StatusReason:        p.StatusReason,
+		StatusExpiresAt:     p.StatusExpiresAt,
+		StatusSetByRole:     p.StatusSetByRole,
+		PhotoKey:            p.PhotoKey,
+		PhotoURL:            p.PhotoURL,
+		PhotoThumbnailURL:   p.PhotoThumbnailURL,
+		CreatedAt:           p.CreatedAt,
+		UpdatedAt:           p.UpdatedAt,
+		DeletedAt:           p.DeletedAt,
+		Version:             p.Version,
 	}
-}
\ No newline at end of file
+}
+
+// nullableString stores empty values as NULL, so persons without a document
+// or phone, such as those created through social login, do not collide on
+// the unique indexes.
+func nullableString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
@@ -2,6 +2,8 @@ package person
 
 import (
 	"database/sql"
+	"strings"
+	"time"
 
 	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
 	"github.com/EstebanGitPro/motogo-backend/core/ports"
@@ -18,25 +20,63 @@ func NewRepository(db *sql.DB) ports.Repository {
 	}
 }
 
+// Unique indexes of the persons table besides the email.
 const (
-	querySave       = "INSERT INTO persons (id, identity_number, first_name, last_name, second_last_name, email, phone_number, email_verified, phone_number_verified, password, role) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
-	queryGetByEmail = "SELECT id, identity_number, first_name, last_name, second_last_name, email, phone_number, email_verified, phone_number_verified, password, role FROM persons WHERE email = ? LIMIT 1"
+	documentUniqueIndex = "uq_persons_document"
+	phoneUniqueIndex    = "uq_persons_phone"
+)
+
+const (
+	personColumns = "id, document_type, identity_number, first_name, last_name, second_last_name, email, phone_number, country, email_verified, phone_number_verified, password, role, status, status_reason, status_expires_at, status_set_by_role, photo_key, photo_url, photo_thumbnail_url, created_at, updated_at, deleted_at, version"
+
+	querySave       = "INSERT INTO persons (" + personColumns + ", email_canonical) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	queryGetByEmail = "SELECT " + personColumns + " FROM persons WHERE email_canonical = ? AND deleted_at IS NULL LIMIT 1"
+	queryGetByID    = "SELECT " + personColumns + " FROM persons WHERE id = ? AND deleted_at IS NULL LIMIT 1"
+	queryList       = "SELECT " + personColumns + " FROM persons"
+
+	queryListEmailAccounts = "SELECT id, email FROM persons WHERE deleted_at IS NULL"
+
+	// Every write bumps the version and updated_at, so an ETag handed out
+	// before any change to the row stops matching.
+	touch      = ", updated_at = ?, version = version + 1"
+	whereAlive = " WHERE id = ? AND deleted_at IS NULL"
+
+	querySetEmailVerified       = "UPDATE persons SET email_verified = TRUE" + touch + whereAlive
+	querySetPhoneNumberVerified = "UPDATE persons SET phone_number_verified = TRUE" + touch + whereAlive
+	queryUpdateRole             = "UPDATE persons SET role = ?" + touch + whereAlive + " AND version = ?"
+	queryUpdatePassword         = "UPDATE persons SET password = ?" + touch + whereAlive
+	queryUpdateStatus           = "UPDATE persons SET status = ?, status_reason = ?, status_expires_at = ?, status_set_by_role = ?" + touch + whereAlive + " AND version = ?"
+	queryUpdatePhoto            = "UPDATE persons SET photo_key = ?, photo_url = ?, photo_thumbnail_url = ?" + touch + whereAlive + " AND version = ?"
+	queryUpdate                 = "UPDATE persons SET first_name = ?, last_name = ?, second_last_name = ?, email = ?, email_canonical = ?, phone_number = ?, country = ?, email_verified = ?, phone_number_verified = ?" + touch + whereAlive + " AND version = ?"
 )
 
 func (r *repository) Save(person domain.Person) error {
 
 	personToSave := Person{
 		ID:                  person.ID,
-		IdentityNumber:      person.IdentityNumber,
+		DocumentType:        nullableString(person.DocumentType),
+		IdentityNumber:      nullableString(person.IdentityNumber),
 		FirstName:           person.FirstName,
 		LastName:            person.LastName,
 		SecondLastName:      person.SecondLastName,
 		Email:               person.Email,
-		PhoneNumber:         person.PhoneNumber,
+		PhoneNumber:         nullableString(person.PhoneNumber),
+		Country:             person.Country,
 		EmailVerified:       person.EmailVerified,
 		PhoneNumberVerified: person.PhoneNumberVerified,
 		Password:            person.Password,
 		Role:                person.Role,
+		Status:              person.Status,
+		StatusReason:        person.StatusReason,
+		StatusExpiresAt:     person.StatusExpiresAt,
+		StatusSetByRole:     person.StatusSetByRole,
+		PhotoKey:            person.PhotoKey,
+		PhotoURL:            person.PhotoURL,
+		PhotoThumbnailURL:   person.PhotoThumbnailURL,
+		CreatedAt:           person.CreatedAt,
+		UpdatedAt:           person.UpdatedAt,
+		DeletedAt:           person.DeletedAt,
+		Version:             person.Version,
 	}
 
 	stmt, err := r.db.Prepare(querySave)
@@ -47,20 +87,34 @@ func (r *repository) Save(person domain.Person) error {
 
 	_, err = stmt.Exec(
 		personToSave.ID,
+		personToSave.DocumentType,
 		personToSave.IdentityNumber,
 		personToSave.FirstName,
 		personToSave.LastName,
 		personToSave.SecondLastName,
 		personToSave.Email,
 		personToSave.PhoneNumber,
+		personToSave.Country,
 		personToSave.EmailVerified,
 		personToSave.PhoneNumberVerified,
 		personToSave.Password,
 		personToSave.Role,
+		personToSave.Status,
+		personToSave.StatusReason,
+		personToSave.StatusExpiresAt,
+		personToSave.StatusSetByRole,
+		personToSave.PhotoKey,
+		personToSave.PhotoURL,
+		personToSave.PhotoThumbnailURL,
+		personToSave.CreatedAt,
+		personToSave.UpdatedAt,
+		personToSave.DeletedAt,
+		personToSave.Version,
+		domain.CanonicalEmail(personToSave.Email),
 	)
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
-			return domain.ErrDuplicateUser
+			return duplicateError(mysqlErr)
 		} else {
 			return domain.ErrUserCannotSave
 		}
@@ -72,26 +126,283 @@ func (r *repository) Save(person domain.Person) error {
 }
 
 func (r *repository) GetPersonByEmail(email string) (*domain.Person, error) {
+	p, err := scanPerson(r.db.QueryRow(queryGetByEmail, domain.CanonicalEmail(email)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrPersonNotFound
+		}
+		return nil, err
+	}
+	d := p.ToDomain()
+	return &d, nil
+}
+
+func (r *repository) GetPersonByID(id string) (*domain.Person, error) {
+	p, err := scanPerson(r.db.QueryRow(queryGetByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrPersonNotFound
+		}
+		return nil, err
+	}
+	d := p.ToDomain()
+	return &d, nil
+}
+
+func (r *repository) SetEmailVerified(id string) error {
+	if _, err := r.db.Exec(querySetEmailVerified, time.Now(), id); err != nil {
+		return domain.ErrUserCannotSave
+	}
+	return nil
+}
+
+func (r *repository) SetPhoneNumberVerified(id string) error {
+	if _, err := r.db.Exec(querySetPhoneNumberVerified, time.Now(), id); err != nil {
+		return domain.ErrUserCannotSave
+	}
+	return nil
+}
+
+func (r *repository) UpdateRole(id, role string, version int64) error {
+	return r.execAtVersion(queryUpdateRole, role, time.Now(), id, version)
+}
+
+func (r *repository) UpdatePassword(id, passwordHash string) error {
+	if _, err := r.db.Exec(queryUpdatePassword, passwordHash, time.Now(), id); err != nil {
+		return domain.ErrUserCannotSave
+	}
+	return nil
+}
+
+func (r *repository) UpdateStatus(id string, change domain.AccountStatusChange, version int64) error {
+	return r.execAtVersion(queryUpdateStatus, change.Status, change.Reason, change.ExpiresAt, change.SetByRole, time.Now(), id, version)
+}
+
+// UpdatePhoto stores the photo of the person; an empty photo removes it.
+func (r *repository) UpdatePhoto(id string, photo domain.ProfilePhoto, version int64) error {
+	return r.execAtVersion(queryUpdatePhoto, photo.Key, photo.URL, photo.ThumbnailURL, time.Now(), id, version)
+}
+
+// execAtVersion runs an update whose last argument is the version the row
+// must still be at, failing with ErrVersionConflict when it no longer is.
+func (r *repository) execAtVersion(query string, args ...any) error {
+	result, err := r.db.Exec(query, args...)
+	if err != nil {
+		return domain.ErrUserCannotSave
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return domain.ErrUserCannotSave
+	}
+	if updated == 0 {
+		return domain.ErrVersionConflict
+	}
+	return nil
+}
+
+// Update stores the person only if the row is still at person.Version and
+// fails with ErrVersionConflict otherwise.
+func (r *repository) Update(person domain.Person) error {
+	p := FromDomain(person)
+
+	result, err := r.db.Exec(queryUpdate,
+		p.FirstName,
+		p.LastName,
+		p.SecondLastName,
+		p.Email,
+		domain.CanonicalEmail(p.Email),
+		p.PhoneNumber,
+		p.Country,
+		p.EmailVerified,
+		p.PhoneNumberVerified,
+		p.UpdatedAt,
+		p.ID,
+		p.Version,
+	)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+			return duplicateError(mysqlErr)
+		}
+		return domain.ErrUserCannotSave
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return domain.ErrUserCannotSave
+	}
+	if updated == 0 {
+		return domain.ErrVersionConflict
+	}
+	return nil
+}
+
+// ListEmailAccounts reads the email of every person. It backs the one-off
+// collision report and is not meant for request paths.
+func (r *repository) ListEmailAccounts() ([]domain.EmailAccount, error) {
+	rows, err := r.db.Query(queryListEmailAccounts)
+	if err != nil {
+		return nil, domain.ErrUserCannotGet
+	}
+	defer rows.Close()
+
+	accounts := []domain.EmailAccount{}
+	for rows.Next() {
+		var account domain.EmailAccount
+		if err := rows.Scan(&account.PersonID, &account.Email); err != nil {
+			return nil, domain.ErrUserCannotGet
+		}
+		accounts = append(accounts, account)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.ErrUserCannotGet
+	}
+
+	return accounts, nil
+}
+
+// sortColumns maps the sort fields to their columns. Only these names are
+// ever concatenated into the listing query.
+var sortColumns = map[string]string{
+	domain.PersonSortFirstName: "first_name",
+	domain.PersonSortLastName:  "last_name",
+	domain.PersonSortEmail:     "email",
+}
+
+// ListPersons reads one page of persons with keyset pagination: the cursor
+// turns into a range condition on (sort column, id), so each page is a short
+// index range scan instead of an OFFSET over the whole table. It reads
+// query.Limit rows; callers ask for one extra to learn whether more remain.
+func (r *repository) ListPersons(query domain.PersonQuery) ([]domain.Person, error) {
+	column, ok := sortColumns[query.Sort.Field]
+	if !ok {
+		return nil, domain.ErrInvalidSortKey
+	}
+
+	var conditions []string
+	var args []any
+
+	filter := query.Filter
+	if !filter.IncludeDeleted {
+		conditions = append(conditions, "deleted_at IS NULL")
+	}
+	if filter.Role != "" {
+		conditions = append(conditions, "role = ?")
+		args = append(args, filter.Role)
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.EmailVerified != nil {
+		conditions = append(conditions, "email_verified = ?")
+		args = append(args, *filter.EmailVerified)
+	}
+	if filter.PhoneNumberVerified != nil {
+		conditions = append(conditions, "phone_number_verified = ?")
+		args = append(args, *filter.PhoneNumberVerified)
+	}
+	if filter.NamePrefix != "" {
+		prefix := escapeLike(filter.NamePrefix) + "%"
+		conditions = append(conditions, "(first_name LIKE ? OR last_name LIKE ?)")
+		args = append(args, prefix, prefix)
+	}
+	if filter.DocumentType != "" {
+		conditions = append(conditions, "document_type = ?")
+		args = append(args, filter.DocumentType)
+	}
+	if filter.IdentityNumber != "" {
+		conditions = append(conditions, "identity_number = ?")
+		args = append(args, filter.IdentityNumber)
+	}
+	if filter.PhoneNumber != "" {
+		conditions = append(conditions, "phone_number = ?")
+		args = append(args, filter.PhoneNumber)
+	}
+
+	comparison, direction := ">", "ASC"
+	if query.Sort.Descending {
+		comparison, direction = "<", "DESC"
+	}
+	if query.After != nil {
+		conditions = append(conditions, "("+column+" "+comparison+" ? OR ("+column+" = ? AND id "+comparison+" ?))")
+		args = append(args, query.After.Value, query.After.Value, query.After.ID)
+	}
+
+	statement := queryList
+	if len(conditions) > 0 {
+		statement += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	statement += " ORDER BY " + column + " " + direction + ", id " + direction + " LIMIT ?"
+	args = append(args, query.Limit)
+
+	rows, err := r.db.Query(statement, args...)
+	if err != nil {
+		return nil, domain.ErrUserCannotGet
+	}
+	defer rows.Close()
+
+	persons := []domain.Person{}
+	for rows.Next() {
+		p, err := scanPerson(rows)
+		if err != nil {
+			return nil, domain.ErrUserCannotGet
+		}
+		persons = append(persons, p.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.ErrUserCannotGet
+	}
+
+	return persons, nil
+}
+
+// duplicateError tells which unique index a duplicate entry violated.
+func duplicateError(mysqlErr *mysql.MySQLError) error {
+	if strings.Contains(mysqlErr.Message, documentUniqueIndex) {
+		return domain.ErrDuplicateIdentityDocument
+	}
+	if strings.Contains(mysqlErr.Message, phoneUniqueIndex) {
+		return domain.ErrDuplicatePhoneNumber
+	}
+	return domain.ErrDuplicateUser
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPerson(row rowScanner) (Person, error) {
 	var p Person
-	err := r.db.QueryRow(queryGetByEmail, email).Scan(
+	err := row.Scan(
 		&p.ID,
+		&p.DocumentType,
 		&p.IdentityNumber,
 		&p.FirstName,
 		&p.LastName,
 		&p.SecondLastName,
 		&p.Email,
 		&p.PhoneNumber,
+		&p.Country,
 		&p.EmailVerified,
 		&p.PhoneNumberVerified,
 		&p.Password,
 		&p.Role,
+		&p.Status,
+		&p.StatusReason,
+		&p.StatusExpiresAt,
+		&p.StatusSetByRole,
+		&p.PhotoKey,
+		&p.PhotoURL,
+		&p.PhotoThumbnailURL,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+		&p.DeletedAt,
+		&p.Version,
 	)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			return nil, domain.ErrPersonNotFound
-		}
-		return nil, err
-	}
-	d := p.ToDomain()
-	return &d, nil
-}
\ No newline at end of file
+	return p, err
+}
@@ -0,0 +1,52 @@
+package phoneotp
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type PhoneOTP struct {
+	ID          string       `db:"id"`
+	PersonID    string       `db:"person_id"`
+	PhoneNumber string       `db:"phone_number"`
+	CodeHash    string       `db:"code_hash"`
+	Attempts    int          `db:"attempts"`
+	ExpiresAt   time.Time    `db:"expires_at"`
+	CreatedAt   time.Time    `db:"created_at"`
+	ConsumedAt  sql.NullTime `db:"consumed_at"`
+}
+
+func (o PhoneOTP) ToDomain() domain.PhoneOTP {
+	otp := domain.PhoneOTP{
+		ID:          o.ID,
+		PersonID:    o.PersonID,
+		PhoneNumber: o.PhoneNumber,
+		CodeHash:    o.CodeHash,
+		Attempts:    o.Attempts,
+		ExpiresAt:   o.ExpiresAt,
+		CreatedAt:   o.CreatedAt,
+	}
+	if o.ConsumedAt.Valid {
+		consumedAt := o.ConsumedAt.Time
+		otp.ConsumedAt = &consumedAt
+	}
+	return otp
+}
+
+func FromDomain(o domain.PhoneOTP) PhoneOTP {
+	otp := PhoneOTP{
+		ID:          o.ID,
+		PersonID:    o.PersonID,
+		PhoneNumber: o.PhoneNumber,
+		CodeHash:    o.CodeHash,
+		Attempts:    o.Attempts,
+		ExpiresAt:   o.ExpiresAt,
+		CreatedAt:   o.CreatedAt,
+	}
+	if o.ConsumedAt != nil {
+		otp.ConsumedAt = sql.NullTime{Time: *o.ConsumedAt, Valid: true}
+	}
+	return otp
+}
@@ -0,0 +1,102 @@
+package phoneotp
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) ports.PhoneOTPRepository {
+	return &repository{
+		db: db,
+	}
+}
+
+const (
+	querySave              = "INSERT INTO phone_otps (id, person_id, phone_number, code_hash, attempts, expires_at, created_at, consumed_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	queryGetLatestByPerson = "SELECT id, person_id, phone_number, code_hash, attempts, expires_at, created_at, consumed_at FROM phone_otps WHERE person_id = ? ORDER BY created_at DESC LIMIT 1"
+	queryIncrementAttempts = "UPDATE phone_otps SET attempts = attempts + 1 WHERE id = ? AND attempts < ?"
+	queryMarkConsumed      = "UPDATE phone_otps SET consumed_at = ? WHERE id = ? AND consumed_at IS NULL"
+)
+
+func (r *repository) Save(otp domain.PhoneOTP) error {
+	otpToSave := FromDomain(otp)
+
+	stmt, err := r.db.Prepare(querySave)
+	if err != nil {
+		return domain.ErrOTPCannotSave
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(
+		otpToSave.ID,
+		otpToSave.PersonID,
+		otpToSave.PhoneNumber,
+		otpToSave.CodeHash,
+		otpToSave.Attempts,
+		otpToSave.ExpiresAt,
+		otpToSave.CreatedAt,
+		otpToSave.ConsumedAt,
+	)
+	if err != nil {
+		return domain.ErrOTPCannotSave
+	}
+
+	return nil
+}
+
+func (r *repository) GetLatestByPerson(personID string) (*domain.PhoneOTP, error) {
+	var o PhoneOTP
+	err := r.db.QueryRow(queryGetLatestByPerson, personID).Scan(
+		&o.ID,
+		&o.PersonID,
+		&o.PhoneNumber,
+		&o.CodeHash,
+		&o.Attempts,
+		&o.ExpiresAt,
+		&o.CreatedAt,
+		&o.ConsumedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrOTPNotFound
+		}
+		return nil, domain.ErrOTPCannotGet
+	}
+	d := o.ToDomain()
+	return &d, nil
+}
+
+func (r *repository) IncrementAttempts(id string, maxAttempts int) (bool, error) {
+	result, err := r.db.Exec(queryIncrementAttempts, id, maxAttempts)
+	if err != nil {
+		return false, domain.ErrOTPCannotSave
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, domain.ErrOTPCannotSave
+	}
+
+	return affected == 1, nil
+}
+
+func (r *repository) MarkConsumed(id string, consumedAt time.Time) (bool, error) {
+	result, err := r.db.Exec(queryMarkConsumed, consumedAt, id)
+	if err != nil {
+		return false, domain.ErrOTPCannotSave
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, domain.ErrOTPCannotSave
+	}
+
+	return affected == 1, nil
+}
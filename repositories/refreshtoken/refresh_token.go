@@ -0,0 +1,57 @@
+package refreshtoken
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type RefreshToken struct {
+	ID        string       `db:"id"`
+	PersonID  string       `db:"person_id"`
+	FamilyID  string       `db:"family_id"`
+	TokenHash string       `db:"token_hash"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	CreatedAt time.Time    `db:"created_at"`
+	UsedAt    sql.NullTime `db:"used_at"`
+	RevokedAt sql.NullTime `db:"revoked_at"`
+}
+
+func (t RefreshToken) ToDomain() domain.RefreshToken {
+	token := domain.RefreshToken{
+		ID:        t.ID,
+		PersonID:  t.PersonID,
+		FamilyID:  t.FamilyID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}
+	if t.UsedAt.Valid {
+		usedAt := t.UsedAt.Time
+		token.UsedAt = &usedAt
+	}
+	if t.RevokedAt.Valid {
+		revokedAt := t.RevokedAt.Time
+		token.RevokedAt = &revokedAt
+	}
+	return token
+}
+
+func FromDomain(t domain.RefreshToken) RefreshToken {
+	token := RefreshToken{
+		ID:        t.ID,
+		PersonID:  t.PersonID,
+		FamilyID:  t.FamilyID,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}
+	if t.UsedAt != nil {
+		token.UsedAt = sql.NullTime{Time: *t.UsedAt, Valid: true}
+	}
+	if t.RevokedAt != nil {
+		token.RevokedAt = sql.NullTime{Time: *t.RevokedAt, Valid: true}
+	}
+	return token
+}
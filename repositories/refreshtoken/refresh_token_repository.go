@@ -0,0 +1,103 @@
+package refreshtoken
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) ports.RefreshTokenRepository {
+	return &repository{
+		db: db,
+	}
+}
+
+const (
+	querySave               = "INSERT INTO refresh_tokens (id, person_id, family_id, token_hash, expires_at, created_at, used_at, revoked_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	queryGetByHash          = "SELECT id, person_id, family_id, token_hash, expires_at, created_at, used_at, revoked_at FROM refresh_tokens WHERE token_hash = ? LIMIT 1"
+	queryMarkUsed           = "UPDATE refresh_tokens SET used_at = ? WHERE id = ? AND used_at IS NULL AND revoked_at IS NULL"
+	queryRevokeFamily       = "UPDATE refresh_tokens SET revoked_at = ? WHERE family_id = ? AND revoked_at IS NULL"
+	queryRevokeAllForPerson = "UPDATE refresh_tokens SET revoked_at = ? WHERE person_id = ? AND revoked_at IS NULL"
+)
+
+func (r *repository) Save(token domain.RefreshToken) error {
+	tokenToSave := FromDomain(token)
+
+	stmt, err := r.db.Prepare(querySave)
+	if err != nil {
+		return domain.ErrRefreshTokenCannotSave
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(
+		tokenToSave.ID,
+		tokenToSave.PersonID,
+		tokenToSave.FamilyID,
+		tokenToSave.TokenHash,
+		tokenToSave.ExpiresAt,
+		tokenToSave.CreatedAt,
+		tokenToSave.UsedAt,
+		tokenToSave.RevokedAt,
+	)
+	if err != nil {
+		return domain.ErrRefreshTokenCannotSave
+	}
+
+	return nil
+}
+
+func (r *repository) GetByHash(tokenHash string) (*domain.RefreshToken, error) {
+	var t RefreshToken
+	err := r.db.QueryRow(queryGetByHash, tokenHash).Scan(
+		&t.ID,
+		&t.PersonID,
+		&t.FamilyID,
+		&t.TokenHash,
+		&t.ExpiresAt,
+		&t.CreatedAt,
+		&t.UsedAt,
+		&t.RevokedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrRefreshTokenNotFound
+		}
+		return nil, domain.ErrRefreshTokenCannotGet
+	}
+	d := t.ToDomain()
+	return &d, nil
+}
+
+func (r *repository) MarkUsed(id string, usedAt time.Time) (bool, error) {
+	result, err := r.db.Exec(queryMarkUsed, usedAt, id)
+	if err != nil {
+		return false, domain.ErrRefreshTokenCannotSave
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, domain.ErrRefreshTokenCannotSave
+	}
+
+	return affected == 1, nil
+}
+
+func (r *repository) RevokeFamily(familyID string) error {
+	if _, err := r.db.Exec(queryRevokeFamily, time.Now(), familyID); err != nil {
+		return domain.ErrRefreshTokenCannotSave
+	}
+	return nil
+}
+
+func (r *repository) RevokeAllForPerson(personID string) error {
+	if _, err := r.db.Exec(queryRevokeAllForPerson, time.Now(), personID); err != nil {
+		return domain.ErrRefreshTokenCannotSave
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package session
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type Session struct {
+	ID         string       `db:"id"`
+	PersonID   string       `db:"person_id"`
+	DeviceName string       `db:"device_name"`
+	Platform   string       `db:"platform"`
+	IP         string       `db:"ip"`
+	UserAgent  string       `db:"user_agent"`
+	CreatedAt  time.Time    `db:"created_at"`
+	LastSeenAt time.Time    `db:"last_seen_at"`
+	RevokedAt  sql.NullTime `db:"revoked_at"`
+}
+
+func (s Session) ToDomain() domain.Session {
+	session := domain.Session{
+		ID:         s.ID,
+		PersonID:   s.PersonID,
+		DeviceName: s.DeviceName,
+		Platform:   s.Platform,
+		IP:         s.IP,
+		UserAgent:  s.UserAgent,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+	}
+	if s.RevokedAt.Valid {
+		revokedAt := s.RevokedAt.Time
+		session.RevokedAt = &revokedAt
+	}
+	return session
+}
+
+func FromDomain(s domain.Session) Session {
+	session := Session{
+		ID:         s.ID,
+		PersonID:   s.PersonID,
+		DeviceName: s.DeviceName,
+		Platform:   s.Platform,
+		IP:         s.IP,
+		UserAgent:  s.UserAgent,
+		CreatedAt:  s.CreatedAt,
+		LastSeenAt: s.LastSeenAt,
+	}
+	if s.RevokedAt != nil {
+		session.RevokedAt = sql.NullTime{Time: *s.RevokedAt, Valid: true}
+	}
+	return session
+}
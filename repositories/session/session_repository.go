@@ -0,0 +1,135 @@
+package session
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) ports.SessionRepository {
+	return &repository{
+		db: db,
+	}
+}
+
+const (
+	sessionColumns = "id, person_id, device_name, platform, ip, user_agent, created_at, last_seen_at, revoked_at"
+
+	querySave               = "INSERT INTO sessions (" + sessionColumns + ") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	queryGetByID            = "SELECT " + sessionColumns + " FROM sessions WHERE id = ?"
+	queryListActiveByPerson = "SELECT " + sessionColumns + " FROM sessions WHERE person_id = ? AND revoked_at IS NULL ORDER BY last_seen_at DESC"
+	queryListByPerson       = "SELECT " + sessionColumns + " FROM sessions WHERE person_id = ? ORDER BY created_at DESC"
+	queryTouch              = "UPDATE sessions SET ip = ?, last_seen_at = ? WHERE id = ? AND revoked_at IS NULL"
+	queryRevoke             = "UPDATE sessions SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL"
+	queryRevokeAllForPerson = "UPDATE sessions SET revoked_at = ? WHERE person_id = ? AND revoked_at IS NULL"
+)
+
+func (r *repository) Save(session domain.Session) error {
+	sessionToSave := FromDomain(session)
+
+	_, err := r.db.Exec(querySave,
+		sessionToSave.ID,
+		sessionToSave.PersonID,
+		sessionToSave.DeviceName,
+		sessionToSave.Platform,
+		sessionToSave.IP,
+		sessionToSave.UserAgent,
+		sessionToSave.CreatedAt,
+		sessionToSave.LastSeenAt,
+		sessionToSave.RevokedAt,
+	)
+	if err != nil {
+		return domain.ErrSessionCannotSave
+	}
+
+	return nil
+}
+
+func (r *repository) GetByID(id string) (*domain.Session, error) {
+	s, err := scanSession(r.db.QueryRow(queryGetByID, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, domain.ErrSessionCannotGet
+	}
+	d := s.ToDomain()
+	return &d, nil
+}
+
+func (r *repository) ListActiveByPerson(personID string) ([]domain.Session, error) {
+	return r.list(queryListActiveByPerson, personID)
+}
+
+func (r *repository) ListByPerson(personID string) ([]domain.Session, error) {
+	return r.list(queryListByPerson, personID)
+}
+
+func (r *repository) list(query string, args ...any) ([]domain.Session, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, domain.ErrSessionCannotGet
+	}
+	defer rows.Close()
+
+	sessions := []domain.Session{}
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, domain.ErrSessionCannotGet
+		}
+		sessions = append(sessions, s.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.ErrSessionCannotGet
+	}
+
+	return sessions, nil
+}
+
+func (r *repository) Touch(id, ip string, at time.Time) error {
+	if _, err := r.db.Exec(queryTouch, ip, at, id); err != nil {
+		return domain.ErrSessionCannotSave
+	}
+	return nil
+}
+
+func (r *repository) Revoke(id string, at time.Time) error {
+	if _, err := r.db.Exec(queryRevoke, at, id); err != nil {
+		return domain.ErrSessionCannotSave
+	}
+	return nil
+}
+
+func (r *repository) RevokeAllForPerson(personID string, at time.Time) error {
+	if _, err := r.db.Exec(queryRevokeAllForPerson, at, personID); err != nil {
+		return domain.ErrSessionCannotSave
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSession(row rowScanner) (Session, error) {
+	var s Session
+	err := row.Scan(
+		&s.ID,
+		&s.PersonID,
+		&s.DeviceName,
+		&s.Platform,
+		&s.IP,
+		&s.UserAgent,
+		&s.CreatedAt,
+		&s.LastSeenAt,
+		&s.RevokedAt,
+	)
+	return s, err
+}
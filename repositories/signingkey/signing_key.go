@@ -0,0 +1,59 @@
+package signingkey
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+// SigningKey stores the private key encrypted; the repository seals and
+// opens it.
+type SigningKey struct {
+	ID          string       `db:"kid"`
+	Algorithm   string       `db:"algorithm"`
+	PrivateKey  string       `db:"private_key_ciphertext"`
+	PublicKey   string       `db:"public_key_pem"`
+	CreatedAt   time.Time    `db:"created_at"`
+	ActivatesAt time.Time    `db:"activates_at"`
+	RetiredAt   sql.NullTime `db:"retired_at"`
+	VerifyUntil sql.NullTime `db:"verify_until"`
+}
+
+func (k SigningKey) ToDomain() domain.SigningKey {
+	key := domain.SigningKey{
+		ID:          k.ID,
+		Algorithm:   k.Algorithm,
+		PrivateKey:  k.PrivateKey,
+		PublicKey:   k.PublicKey,
+		CreatedAt:   k.CreatedAt,
+		ActivatesAt: k.ActivatesAt,
+	}
+	if k.RetiredAt.Valid {
+		retiredAt := k.RetiredAt.Time
+		key.RetiredAt = &retiredAt
+	}
+	if k.VerifyUntil.Valid {
+		verifyUntil := k.VerifyUntil.Time
+		key.VerifyUntil = &verifyUntil
+	}
+	return key
+}
+
+func FromDomain(k domain.SigningKey) SigningKey {
+	key := SigningKey{
+		ID:          k.ID,
+		Algorithm:   k.Algorithm,
+		PrivateKey:  k.PrivateKey,
+		PublicKey:   k.PublicKey,
+		CreatedAt:   k.CreatedAt,
+		ActivatesAt: k.ActivatesAt,
+	}
+	if k.RetiredAt != nil {
+		key.RetiredAt = sql.NullTime{Time: *k.RetiredAt, Valid: true}
+	}
+	if k.VerifyUntil != nil {
+		key.VerifyUntil = sql.NullTime{Time: *k.VerifyUntil, Valid: true}
+	}
+	return key
+}
@@ -0,0 +1,100 @@
+package signingkey
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+	"github.com/EstebanGitPro/motogo-backend/platform/secretbox"
+)
+
+type repository struct {
+	db  *sql.DB
+	box *secretbox.SecretBox
+}
+
+func NewRepository(db *sql.DB, box *secretbox.SecretBox) ports.SigningKeyRepository {
+	return &repository{
+		db:  db,
+		box: box,
+	}
+}
+
+const (
+	querySave           = "INSERT INTO jwt_signing_keys (kid, algorithm, private_key_ciphertext, public_key_pem, created_at, activates_at, retired_at, verify_until) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	queryListVerifiable = "SELECT kid, algorithm, private_key_ciphertext, public_key_pem, created_at, activates_at, retired_at, verify_until FROM jwt_signing_keys WHERE verify_until IS NULL OR verify_until > ? ORDER BY created_at DESC"
+	queryRetireActive   = "UPDATE jwt_signing_keys SET retired_at = ?, verify_until = ? WHERE retired_at IS NULL AND kid <> ?"
+)
+
+func (r *repository) Save(key domain.SigningKey) error {
+	sealed, err := r.box.Seal(key.PrivateKey)
+	if err != nil {
+		return domain.ErrSigningKeyCannotSave
+	}
+
+	keyToSave := FromDomain(key)
+	keyToSave.PrivateKey = sealed
+
+	_, err = r.db.Exec(querySave,
+		keyToSave.ID,
+		keyToSave.Algorithm,
+		keyToSave.PrivateKey,
+		keyToSave.PublicKey,
+		keyToSave.CreatedAt,
+		keyToSave.ActivatesAt,
+		keyToSave.RetiredAt,
+		keyToSave.VerifyUntil,
+	)
+	if err != nil {
+		return domain.ErrSigningKeyCannotSave
+	}
+
+	return nil
+}
+
+func (r *repository) ListVerifiable(at time.Time) ([]domain.SigningKey, error) {
+	rows, err := r.db.Query(queryListVerifiable, at)
+	if err != nil {
+		return nil, domain.ErrSigningKeyCannotGet
+	}
+	defer rows.Close()
+
+	var keys []domain.SigningKey
+	for rows.Next() {
+		var k SigningKey
+		err := rows.Scan(
+			&k.ID,
+			&k.Algorithm,
+			&k.PrivateKey,
+			&k.PublicKey,
+			&k.CreatedAt,
+			&k.ActivatesAt,
+			&k.RetiredAt,
+			&k.VerifyUntil,
+		)
+		if err != nil {
+			return nil, domain.ErrSigningKeyCannotGet
+		}
+
+		privateKey, err := r.box.Open(k.PrivateKey)
+		if err != nil {
+			return nil, domain.ErrSigningKeyCannotGet
+		}
+		k.PrivateKey = privateKey
+
+		keys = append(keys, k.ToDomain())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, domain.ErrSigningKeyCannotGet
+	}
+
+	return keys, nil
+}
+
+func (r *repository) RetireActive(exceptID string, at, verifyUntil time.Time) error {
+	if _, err := r.db.Exec(queryRetireActive, at, verifyUntil, exceptID); err != nil {
+		return domain.ErrSigningKeyCannotSave
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package verification
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+)
+
+type VerificationToken struct {
+	ID        string       `db:"id"`
+	PersonID  string       `db:"person_id"`
+	Purpose   string       `db:"purpose"`
+	TokenHash string       `db:"token_hash"`
+	ExpiresAt time.Time    `db:"expires_at"`
+	CreatedAt time.Time    `db:"created_at"`
+	UsedAt    sql.NullTime `db:"used_at"`
+}
+
+func (t VerificationToken) ToDomain() domain.VerificationToken {
+	token := domain.VerificationToken{
+		ID:        t.ID,
+		PersonID:  t.PersonID,
+		Purpose:   t.Purpose,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}
+	if t.UsedAt.Valid {
+		usedAt := t.UsedAt.Time
+		token.UsedAt = &usedAt
+	}
+	return token
+}
+
+func FromDomain(t domain.VerificationToken) VerificationToken {
+	token := VerificationToken{
+		ID:        t.ID,
+		PersonID:  t.PersonID,
+		Purpose:   t.Purpose,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}
+	if t.UsedAt != nil {
+		token.UsedAt = sql.NullTime{Time: *t.UsedAt, Valid: true}
+	}
+	return token
+}
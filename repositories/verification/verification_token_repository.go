@@ -0,0 +1,93 @@
+package verification
+
+import (
+	"database/sql"
+	"time"
+
+	domain "github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports"
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) ports.VerificationTokenRepository {
+	return &repository{
+		db: db,
+	}
+}
+
+const (
+	querySave                = "INSERT INTO verification_tokens (id, person_id, purpose, token_hash, expires_at, created_at, used_at) VALUES (?, ?, ?, ?, ?, ?, ?)"
+	queryGetByHash           = "SELECT id, person_id, purpose, token_hash, expires_at, created_at, used_at FROM verification_tokens WHERE token_hash = ? LIMIT 1"
+	queryMarkUsed            = "UPDATE verification_tokens SET used_at = ? WHERE id = ? AND used_at IS NULL"
+	queryInvalidateForPerson = "UPDATE verification_tokens SET used_at = ? WHERE person_id = ? AND purpose = ? AND used_at IS NULL"
+)
+
+func (r *repository) Save(token domain.VerificationToken) error {
+	tokenToSave := FromDomain(token)
+
+	stmt, err := r.db.Prepare(querySave)
+	if err != nil {
+		return domain.ErrVerificationTokenCannotSave
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(
+		tokenToSave.ID,
+		tokenToSave.PersonID,
+		tokenToSave.Purpose,
+		tokenToSave.TokenHash,
+		tokenToSave.ExpiresAt,
+		tokenToSave.CreatedAt,
+		tokenToSave.UsedAt,
+	)
+	if err != nil {
+		return domain.ErrVerificationTokenCannotSave
+	}
+
+	return nil
+}
+
+func (r *repository) GetByHash(tokenHash string) (*domain.VerificationToken, error) {
+	var t VerificationToken
+	err := r.db.QueryRow(queryGetByHash, tokenHash).Scan(
+		&t.ID,
+		&t.PersonID,
+		&t.Purpose,
+		&t.TokenHash,
+		&t.ExpiresAt,
+		&t.CreatedAt,
+		&t.UsedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrVerificationTokenNotFound
+		}
+		return nil, domain.ErrVerificationTokenCannotGet
+	}
+	d := t.ToDomain()
+	return &d, nil
+}
+
+func (r *repository) MarkUsed(id string, usedAt time.Time) (bool, error) {
+	result, err := r.db.Exec(queryMarkUsed, usedAt, id)
+	if err != nil {
+		return false, domain.ErrVerificationTokenCannotSave
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, domain.ErrVerificationTokenCannotSave
+	}
+
+	return affected == 1, nil
+}
+
+func (r *repository) InvalidateForPerson(personID, purpose string) error {
+	if _, err := r.db.Exec(queryInvalidateForPerson, time.Now(), personID, purpose); err != nil {
+		return domain.ErrVerificationTokenCannotSave
+	}
+	return nil
+}
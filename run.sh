@@ -9,5 +9,9 @@ echo "⏳ Esperando a que el contenedor inicie..."
 sleep 5
 
 
+echo "🗄️ Aplicando migraciones..."
+go run /home/devban/Documents/Go/motogo_backend_f/cmd/main.go migrate up
+
+
 echo "🚀 Ejecutando la aplicación Go..."
 go run /home/devban/Documents/Go/motogo_backend_f/cmd/main.go
\ No newline at end of file
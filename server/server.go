@@ -5,6 +5,9 @@ import (
 	"log/slog"
 
 	"github.com/EstebanGitPro/motogo-backend/cmd/dependency"
+	"github.com/EstebanGitPro/motogo-backend/config"
+	"github.com/EstebanGitPro/motogo-backend/core/domain"
+	"github.com/EstebanGitPro/motogo-backend/core/ports/token"
 	"github.com/EstebanGitPro/motogo-backend/handlers"
 	"github.com/EstebanGitPro/motogo-backend/middleware"
 	"github.com/EstebanGitPro/motogo-backend/platform/schema"
@@ -15,7 +18,7 @@ import (
 func routing(app *gin.Engine, dependencies *dependency.Dependencies) {
 	slog.Info("Setting up routes")
 
-	handler := handlers.New(dependencies.PersonService)
+	handler := handlers.New(dependencies.PersonService, dependencies.AuthService, dependencies.VerificationService, dependencies.PhoneService, dependencies.PasswordService, dependencies.MFAService, dependencies.SessionService, dependencies.SigningKeyService, dependencies.APIKeyService, dependencies.OIDCService, dependencies.PrivacyService, dependencies.PhotoService)
 
 
 	validators, err := schema.NewValidator(&schema.DefaultFileReader{})
@@ -24,11 +27,77 @@ func routing(app *gin.Engine, dependencies *dependency.Dependencies) {
 		return
 	}
 	validator := middleware.NewMiddlewareValidator(validators)
+	authMiddleware := middleware.NewAuthMiddleware(dependencies.TokenGenerator, dependencies.SessionService, dependencies.APIKeyService, dependencies.PersonService)
+
+	app.GET("/.well-known/jwks.json", handler.JWKS())
 
 	public := app.Group("/v1/motogo")
 	{
 		public.POST("/users", validator.WithValidateRegister(), handler.RegisterPerson())
-		public.GET("/users/email/:email", handler.GetPersonByEmail())
+	}
+
+	protected := authMiddleware.Protect(app.Group("/v1/motogo"))
+	{
+		protected.GET("/users/email/:email", middleware.Roles(domain.RoleAdmin, domain.RoleSupport).WithScopes(domain.ScopePersonsRead), handler.GetPersonByEmail())
+		protected.GET("/users/me", middleware.AnyAuthenticated(), handler.GetCurrentPerson())
+		protected.PATCH("/users/me", middleware.AnyAuthenticated(), validator.WithValidateUpdatePerson(), handler.UpdateCurrentPerson())
+		protected.PUT("/users/me/photo", middleware.AnyAuthenticated(), middleware.LimitUploadSize(dependencies.Config.ProfilePhoto.MaxBytes()), handler.UploadCurrentPersonPhoto())
+		protected.DELETE("/users/me/photo", middleware.AnyAuthenticated(), handler.DeleteCurrentPersonPhoto())
+		protected.GET("/users/me/data-export", middleware.AnyAuthenticated(), handler.ExportPersonData())
+		protected.POST("/users/me/deletion", middleware.AnyAuthenticated(), handler.RequestAccountDeletion())
+		protected.GET("/users/me/deletion", middleware.AnyAuthenticated(), handler.GetAccountDeletion())
+		protected.DELETE("/users/me/deletion", middleware.AnyAuthenticated(), handler.CancelAccountDeletion())
+		protected.GET("/users/:id", middleware.Roles(domain.RoleAdmin, domain.RoleSupport).WithScopes(domain.ScopePersonsRead), handler.GetPersonByID())
+		protected.PUT("/users/me/password", middleware.AnyAuthenticated(), validator.WithValidateChangePassword(), handler.ChangePassword())
+		protected.POST("/users/me/phone/verification", middleware.AnyAuthenticated(), handler.RequestPhoneOTP())
+		protected.POST("/users/me/phone/verification/confirm", middleware.AnyAuthenticated(), validator.WithValidatePhoneOTP(), handler.ConfirmPhoneOTP())
+		protected.GET("/users/me/sessions", middleware.AnyAuthenticated(), handler.ListSessions())
+		protected.DELETE("/users/me/sessions/:id", middleware.AnyAuthenticated(), handler.RevokeSession())
+		protected.POST("/users/me/mfa/totp", middleware.Roles(domain.MFARoles...).WithPurposes(token.PurposeMFAEnrollment), handler.BeginTOTPEnrollment())
+		protected.POST("/users/me/mfa/totp/confirm", middleware.Roles(domain.MFARoles...).WithPurposes(token.PurposeMFAEnrollment), validator.WithValidateMFACode(), handler.ConfirmTOTPEnrollment())
+		protected.DELETE("/users/me/mfa/totp", middleware.Roles(domain.MFARoles...), validator.WithValidateMFACode(), handler.DisableTOTP())
+	}
+
+	admin := protected.Group("/admin")
+	{
+		admin.GET("/users", middleware.Roles(domain.RoleAdmin, domain.RoleSupport), handler.ListPersons())
+		admin.PUT("/users/:id/status", middleware.Roles(domain.RoleAdmin, domain.RoleSupport), validator.WithValidateAccountStatus(), handler.SetAccountStatus())
+		admin.DELETE("/users/:id/photo", middleware.Roles(domain.RoleAdmin, domain.RoleSupport), handler.DeletePersonPhoto())
+		admin.PUT("/users/:id/role", middleware.Roles(domain.RoleAdmin), validator.WithValidateAssignRole(), handler.AssignRole())
+		admin.DELETE("/users/:id/sessions", middleware.Roles(domain.RoleAdmin), handler.RevokePersonSessions())
+		admin.POST("/api-keys", middleware.Roles(domain.RoleAdmin), validator.WithValidateCreateAPIKey(), handler.CreateAPIKey())
+		admin.GET("/api-keys", middleware.Roles(domain.RoleAdmin), handler.ListAPIKeys())
+		admin.DELETE("/api-keys/:id", middleware.Roles(domain.RoleAdmin), handler.RevokeAPIKey())
+		admin.POST("/keys/rotate", middleware.Roles(domain.RoleAdmin), handler.RotateSigningKey())
+		admin.DELETE("/lockouts", middleware.Roles(domain.RoleAdmin), handler.ClearLoginLockout())
+		admin.GET("/mfa/policies", middleware.Roles(domain.RoleAdmin), handler.ListMFARolePolicies())
+		admin.PUT("/mfa/policies/:role", middleware.Roles(domain.RoleAdmin), validator.WithValidateMFARolePolicy(), handler.SetMFARolePolicy())
+	}
+
+	// Trip routes are registered on this group; riders and drivers need a
+	// verified phone number before they can use them, and drivers a photo
+	// their passengers can recognize them by.
+	trips := protected.Group("/trips")
+	trips.Use(middleware.RequireVerifiedPhone(dependencies.PhoneService, domain.RolePassenger, domain.RoleDriver))
+	trips.Use(middleware.RequireProfilePhoto(dependencies.PersonService, domain.RoleDriver))
+
+	auth := app.Group("/v1/motogo/auth")
+	{
+		auth.POST("/login", validator.WithValidateLogin(), handler.Login())
+		auth.POST("/login/mfa", validator.WithValidateMFALogin(), handler.CompleteMFALogin())
+		auth.POST("/refresh", validator.WithValidateRefreshToken(), handler.RefreshToken())
+		auth.POST("/logout", validator.WithValidateRefreshToken(), handler.Logout())
+		auth.GET("/verify-email", handler.VerifyEmail())
+		auth.POST("/verify-email", validator.WithValidateVerifyEmail(), handler.VerifyEmail())
+		auth.POST("/verify-email/resend", validator.WithValidateEmail(), handler.ResendEmailVerification())
+		auth.POST("/password/forgot", validator.WithValidateEmail(), handler.ForgotPassword())
+		auth.POST("/password/reset", validator.WithValidateResetPassword(), handler.ResetPassword())
+		auth.GET("/oidc/:provider/authorize", handler.BeginOIDCLogin())
+		auth.GET("/oidc/:provider/callback", handler.CompleteOIDCLogin())
+	}
+
+	if !dependencies.Config.Storage.UsesS3() {
+		app.Static(config.LocalMediaPath, dependencies.Config.Storage.Directory())
 	}
 
 }
@@ -36,7 +105,7 @@ func routing(app *gin.Engine, dependencies *dependency.Dependencies) {
 func Boostrap(app *gin.Engine) *dependency.Dependencies {
 	dependencies, err := dependency.Init()
 	if err != nil {
-		log.Fatal("Error initializing dependencies")
+		log.Fatalf("Error initializing dependencies: %v", err)
 		return nil
 	}
 